@@ -9,15 +9,17 @@
 // File Created: 2025-11-28 11:26:19
 //
 // Modified By: S.EE Development Team <dev@s.ee>
-// Last Modified: 2025-12-04 17:58:55
+// Last Modified: 2026-10-19 05:16:01
 //
 
 package seesdk
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/url"
 	"os"
 )
 
@@ -31,10 +33,39 @@ func unmarshalResponse[T any](data []byte, response *T) error {
 
 // CreateShortURL creates a new short URL with the given parameters.
 func (c *Client) CreateShortURL(req CreateShortURLRequest) (*CreateShortURLResponse, error) {
-	respBody, err := c.doRequest("POST", "/shorten", req)
+	return c.createShortURLContext(context.Background(), req)
+}
+
+func (c *Client) createShortURLContext(ctx context.Context, req CreateShortURLRequest) (*CreateShortURLResponse, error) {
+	if req.IdempotencyKey != "" {
+		return idempotent(c, req.IdempotencyKey, "/shorten", req.withoutExpiry(), req.TagNames, func() (*CreateShortURLResponse, error) {
+			return c.createShortURL(ctx, req)
+		})
+	}
+	return c.createShortURL(ctx, req)
+}
+
+func (c *Client) createShortURL(ctx context.Context, req CreateShortURLRequest) (*CreateShortURLResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	domain, err := c.prepareDomain(DomainKindShortURL, req.Domain)
 	if err != nil {
 		return nil, err
 	}
+	req.Domain = domain
+
+	tagIDs, err := c.resolveTagNames(req.TagIDs, req.TagNames)
+	if err != nil {
+		return nil, err
+	}
+	req.TagIDs = tagIDs
+
+	respBody, err := c.doRequestContext(ctx, "POST", "/shorten", req, idempotencyHeader(req.IdempotencyKey))
+	if err != nil {
+		c.invalidateTagNames(req.TagNames)
+		return nil, err
+	}
 
 	var response CreateShortURLResponse
 	if err := unmarshalResponse(respBody, &response); err != nil {
@@ -44,8 +75,27 @@ func (c *Client) CreateShortURL(req CreateShortURLRequest) (*CreateShortURLRespo
 	return &response, nil
 }
 
+// GetShortURL retrieves the current settings of a short URL.
+func (c *Client) GetShortURL(domain, slug string) (*GetShortURLResponse, error) {
+	respBody, err := c.doRequest("GET", "/shorten?"+slugQuery(domain, slug), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response GetShortURLResponse
+	if err := unmarshalResponse(respBody, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // UpdateShortURL updates an existing short URL.
 func (c *Client) UpdateShortURL(request UpdateShortURLRequest) (*UpdateShortURLResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
 	respBody, err := c.doRequest("PUT", "/shorten", request)
 	if err != nil {
 		return nil, err
@@ -61,6 +111,10 @@ func (c *Client) UpdateShortURL(request UpdateShortURLRequest) (*UpdateShortURLR
 
 // DeleteShortURL deletes an existing short URL.
 func (c *Client) DeleteShortURL(request DeleteURLRequest) (*DeleteURLResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
 	respBody, err := c.doRequest("DELETE", "/shorten", request)
 	if err != nil {
 		return nil, err
@@ -124,10 +178,39 @@ func (c *Client) GetTags() (*TagsResponse, error) {
 
 // CreateText creates a new text entry with the given parameters.
 func (c *Client) CreateText(req CreateTextRequest) (*CreateTextResponse, error) {
-	respBody, err := c.doRequest("POST", "/text", req)
+	return c.createTextContext(context.Background(), req)
+}
+
+func (c *Client) createTextContext(ctx context.Context, req CreateTextRequest) (*CreateTextResponse, error) {
+	if req.IdempotencyKey != "" {
+		return idempotent(c, req.IdempotencyKey, "/text", req.withoutExpiry(), req.TagNames, func() (*CreateTextResponse, error) {
+			return c.createText(ctx, req)
+		})
+	}
+	return c.createText(ctx, req)
+}
+
+func (c *Client) createText(ctx context.Context, req CreateTextRequest) (*CreateTextResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	domain, err := c.prepareDomain(DomainKindText, req.Domain)
+	if err != nil {
+		return nil, err
+	}
+	req.Domain = domain
+
+	tagIDs, err := c.resolveTagNames(req.TagIDs, req.TagNames)
 	if err != nil {
 		return nil, err
 	}
+	req.TagIDs = tagIDs
+
+	respBody, err := c.doRequestContext(ctx, "POST", "/text", req, idempotencyHeader(req.IdempotencyKey))
+	if err != nil {
+		c.invalidateTagNames(req.TagNames)
+		return nil, err
+	}
 
 	var response CreateTextResponse
 	if err := unmarshalResponse(respBody, &response); err != nil {
@@ -137,8 +220,28 @@ func (c *Client) CreateText(req CreateTextRequest) (*CreateTextResponse, error)
 	return &response, nil
 }
 
+// GetText retrieves the current settings of a text entry. Use
+// GetTextContent to fetch its content.
+func (c *Client) GetText(domain, slug string) (*GetTextResponse, error) {
+	respBody, err := c.doRequest("GET", "/text?"+slugQuery(domain, slug), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response GetTextResponse
+	if err := unmarshalResponse(respBody, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // UpdateText updates an existing text entry.
 func (c *Client) UpdateText(req UpdateTextRequest) (*UpdateTextResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
 	respBody, err := c.doRequest("PUT", "/text", req)
 	if err != nil {
 		return nil, err
@@ -154,6 +257,10 @@ func (c *Client) UpdateText(req UpdateTextRequest) (*UpdateTextResponse, error)
 
 // DeleteText deletes an existing text entry.
 func (c *Client) DeleteText(req DeleteTextRequest) (*DeleteTextResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
 	respBody, err := c.doRequest("DELETE", "/text", req)
 	if err != nil {
 		return nil, err
@@ -167,18 +274,24 @@ func (c *Client) DeleteText(req DeleteTextRequest) (*DeleteTextResponse, error)
 	return &response, nil
 }
 
+// maxUploadSize is the maximum size of an uploaded file.
+const maxUploadSize = 100 * 1024 * 1024 // 100MB
+
 // UploadFile uploads a file to the server.
 func (c *Client) UploadFile(filename string, file io.Reader) (*UploadFileResponse, error) {
 	if file == nil {
 		return nil, fmt.Errorf("file is nil")
 	}
 
-	const maxFileSize = 100 * 1024 * 1024 // 100MB
-	if err := checkFileSize(file, maxFileSize); err != nil {
+	return c.uploadFile(context.Background(), filename, file)
+}
+
+func (c *Client) uploadFile(ctx context.Context, filename string, file io.Reader) (*UploadFileResponse, error) {
+	if err := checkFileSize(file, maxUploadSize); err != nil {
 		return nil, err
 	}
 
-	respBody, err := c.doMultipartRequest("/file/upload", "file", filename, file)
+	respBody, err := c.doMultipartRequest(ctx, c.HTTPClient, "/file/upload", "file", filename, "", file)
 	if err != nil {
 		return nil, err
 	}
@@ -236,16 +349,30 @@ func (c *Client) GetTextDomains() (*DomainsResponse, error) {
 	return &response, nil
 }
 
+// slugQuery encodes domain and slug as query parameters.
+func slugQuery(domain, slug string) string {
+	query := url.Values{}
+	query.Set("domain", domain)
+	query.Set("slug", slug)
+	return query.Encode()
+}
+
 // checkFileSize checks if the file size exceeds the maximum allowed size.
 func checkFileSize(file io.Reader, maxSize int64) error {
+	if size, ok := readerSize(file); ok && size > maxSize {
+		return fmt.Errorf("file size exceeds the limit of %d bytes", maxSize)
+	}
+	return nil
+}
+
+// readerSize returns the size of file if it can be determined up front.
+func readerSize(file io.Reader) (int64, bool) {
 	if f, ok := file.(interface{ Stat() (os.FileInfo, error) }); ok {
-		if info, err := f.Stat(); err == nil && info.Size() > maxSize {
-			return fmt.Errorf("file size exceeds the limit of %d bytes", maxSize)
+		if info, err := f.Stat(); err == nil {
+			return info.Size(), true
 		}
 	} else if l, ok := file.(interface{ Len() int }); ok {
-		if int64(l.Len()) > maxSize {
-			return fmt.Errorf("file size exceeds the limit of %d bytes", maxSize)
-		}
+		return int64(l.Len()), true
 	}
-	return nil
+	return 0, false
 }
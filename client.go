@@ -1,5 +1,5 @@
 //
-// Copyright (c) 2025 S.EE Development Team
+// Copyright (c) 2025-2026 S.EE Development Team
 //
 // This source code is licensed under the MIT License,
 // which is located in the LICENSE file in the source tree's root directory.
@@ -9,18 +9,22 @@
 // File Created: 2025-11-28 11:21:45
 //
 // Modified By: S.EE Development Team <dev@s.ee>
-// Last Modified: 2025-12-04 18:00:16
+// Last Modified: 2026-10-19 04:52:31
 //
 
 package seesdk
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"mime/multipart"
 	"net/http"
+	"net/textproto"
+	"strings"
+	"sync"
 	"time"
 )
 
@@ -32,13 +36,33 @@ type Client struct {
 	BaseURL    string
 	APIKey     string
 	HTTPClient *http.Client
+	// VerifyDomains checks the domain of create requests against the
+	// cached list of available domains before sending them.
+	VerifyDomains bool
+	// Observer, if set, is called after every API request. It must be set
+	// before the client is used.
+	Observer RequestObserver
+	// IdempotencyCacheSize is the number of idempotency keys whose
+	// responses are remembered (default: DefaultIdempotencyCacheSize).
+	IdempotencyCacheSize int
+
+	tagsOnce    sync.Once
+	tags        *TagResolver
+	domainsOnce sync.Once
+	domains     *DomainCatalog
+
+	idempotencyOnce sync.Once
+	idempotency     *lruCache[string, *idempotentCall]
 }
 
 // Config contains configuration options for the Client
 type Config struct {
-	BaseURL string
-	APIKey  string
-	Timeout time.Duration
+	BaseURL              string
+	APIKey               string
+	Timeout              time.Duration
+	VerifyDomains        bool
+	Observer             RequestObserver
+	IdempotencyCacheSize int
 }
 
 // NewClient creates a new SEE SDK client with the given configuration.
@@ -56,11 +80,54 @@ func NewClient(config Config) *Client {
 		HTTPClient: &http.Client{
 			Timeout: config.Timeout,
 		},
+		VerifyDomains:        config.VerifyDomains,
+		Observer:             config.Observer,
+		IdempotencyCacheSize: config.IdempotencyCacheSize,
 	}
 }
 
+// APIError is returned when the API responds with a non-2xx status code.
+type APIError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API error (status %d): %s", e.StatusCode, e.Body)
+}
+
+// RequestInfo describes a completed API request.
+type RequestInfo struct {
+	Method     string
+	Route      string // Endpoint path with IDs replaced, e.g. /file/delete/{key}
+	StatusCode int    // 0 if no response was received
+	Duration   time.Duration
+	Err        error
+}
+
+// RequestObserver is called after every API request, for example to
+// collect metrics.
+type RequestObserver func(RequestInfo)
+
+// routeOf returns the endpoint path without its query and with path
+// parameters replaced by placeholders.
+func routeOf(endpoint string) string {
+	if i := strings.IndexByte(endpoint, '?'); i >= 0 {
+		endpoint = endpoint[:i]
+	}
+	if strings.HasPrefix(endpoint, "/file/delete/") {
+		return "/file/delete/{key}"
+	}
+	return endpoint
+}
+
 // doRequest executes an HTTP request and returns the response body.
 func (c *Client) doRequest(method, endpoint string, body any) ([]byte, error) {
+	return c.doRequestContext(context.Background(), method, endpoint, body, nil)
+}
+
+// doRequestContext executes an HTTP request bound to ctx with optional extra headers.
+func (c *Client) doRequestContext(ctx context.Context, method, endpoint string, body any, header http.Header) ([]byte, error) {
 	var reqBody io.Reader
 	if body != nil {
 		jsonData, err := json.Marshal(body)
@@ -71,42 +138,39 @@ func (c *Client) doRequest(method, endpoint string, body any) ([]byte, error) {
 	}
 
 	url := c.BaseURL + endpoint
-	req, err := http.NewRequest(method, url, reqBody)
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
 	if err != nil {
 		return nil, fmt.Errorf("create request: %w", err)
 	}
 
-	req.Header.Set("Content-Type", "application/json")
-	if c.APIKey != "" {
-		req.Header.Set("Authorization", c.APIKey)
+	for key, values := range header {
+		req.Header[key] = values
 	}
+	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("execute request: %w", err)
-	}
-	defer resp.Body.Close()
+	return c.send(c.HTTPClient, req, endpoint)
+}
 
-	respBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("read response: %w", err)
-	}
+// quoteEscaper escapes quoted values in multipart headers.
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
 
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(respBody))
+// doMultipartRequest executes a multipart HTTP request with httpClient. An
+// empty contentType sends the file part as application/octet-stream.
+func (c *Client) doMultipartRequest(ctx context.Context, httpClient *http.Client, endpoint string, fieldName, filename, contentType string, r io.Reader) ([]byte, error) {
+	if contentType == "" {
+		contentType = "application/octet-stream"
 	}
 
-	return respBody, nil
-}
-
-// doMultipartRequest executes a multipart HTTP request.
-func (c *Client) doMultipartRequest(endpoint string, fieldName, filename string, r io.Reader) ([]byte, error) {
 	pr, pw := io.Pipe()
 	writer := multipart.NewWriter(pw)
 
 	go func() {
 		defer pw.Close()
-		part, err := writer.CreateFormFile(fieldName, filename)
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`,
+			quoteEscaper.Replace(fieldName), quoteEscaper.Replace(filename)))
+		header.Set("Content-Type", contentType)
+		part, err := writer.CreatePart(header)
 		if err != nil {
 			_ = pw.CloseWithError(fmt.Errorf("create form file: %w", err))
 			return
@@ -121,29 +185,60 @@ func (c *Client) doMultipartRequest(endpoint string, fieldName, filename string,
 	}()
 
 	url := c.BaseURL + endpoint
-	req, err := http.NewRequest("POST", url, pr)
+	req, err := http.NewRequestWithContext(ctx, "POST", url, pr)
 	if err != nil {
 		return nil, fmt.Errorf("create request: %w", err)
 	}
 
 	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	return c.send(httpClient, req, endpoint)
+}
+
+// streamingClient returns a copy of HTTPClient without the whole-request
+// timeout, for transfers that may take longer. Cancellation relies on the
+// request context instead.
+func (c *Client) streamingClient() *http.Client {
+	httpClient := *c.HTTPClient
+	httpClient.Timeout = 0
+	return &httpClient
+}
+
+// send authorizes and executes an API request with httpClient and returns
+// the response body.
+func (c *Client) send(httpClient *http.Client, req *http.Request, endpoint string) (respBody []byte, err error) {
 	if c.APIKey != "" {
 		req.Header.Set("Authorization", c.APIKey)
 	}
 
-	resp, err := c.HTTPClient.Do(req)
+	statusCode := 0
+	if c.Observer != nil {
+		start := time.Now()
+		defer func() {
+			c.Observer(RequestInfo{
+				Method:     req.Method,
+				Route:      routeOf(endpoint),
+				StatusCode: statusCode,
+				Duration:   time.Since(start),
+				Err:        err,
+			})
+		}()
+	}
+
+	resp, err := httpClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("execute request: %w", err)
 	}
 	defer resp.Body.Close()
+	statusCode = resp.StatusCode
 
-	respBody, err := io.ReadAll(resp.Body)
+	respBody, err = io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, fmt.Errorf("read response: %w", err)
 	}
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(respBody))
+		return nil, &APIError{StatusCode: resp.StatusCode, Body: string(respBody)}
 	}
 
 	return respBody, nil
@@ -1,5 +1,5 @@
 //
-// Copyright (c) 2025 S.EE Development Team
+// Copyright (c) 2025-2026 S.EE Development Team
 //
 // This source code is licensed under the MIT License,
 // which is located in the LICENSE file in the source tree's root directory.
@@ -9,7 +9,7 @@
 // File Created: 2025-11-28 11:26:21
 //
 // Modified By: S.EE Development Team <dev@s.ee>
-// Last Modified: 2025-12-04 17:59:00
+// Last Modified: 2026-10-19 04:30:20
 //
 
 package seesdk
@@ -80,7 +80,7 @@ func TestNewClient(t *testing.T) {
 	result, err := client.UpdateShortURL(UpdateShortURLRequest{
 		Domain:    "a.see-test.com",
 		Slug:      response.Data.Slug,
-		Title:     "Google",
+		Title:     Set("Google"),
 		TargetURL: "https://www.google.com/search?q=see+sdk",
 	})
 
@@ -133,7 +133,7 @@ func TestTextOperations(t *testing.T) {
 		Domain:  "ba.sh",
 		Slug:    createResp.Data.Slug,
 		Content: "Hello, World! This is an updated test text.",
-		Title:   "Updated Test Text",
+		Title:   Set("Updated Test Text"),
 	})
 
 	if err != nil {
@@ -0,0 +1,319 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: crypto.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:18:11
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 05:14:32
+//
+
+package seesdk
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"net/url"
+	"strconv"
+)
+
+const (
+	// encryptionVersion is the current version of the encrypted text envelope.
+	encryptionVersion = 1
+
+	encryptionAlgorithm = "aes-256-gcm"
+	kdfPBKDF2SHA256     = "pbkdf2-sha256"
+
+	encryptionKeySize  = 32
+	encryptionSaltSize = 16
+)
+
+// DefaultKDFIterations is the PBKDF2 iteration count used when a passphrase is set.
+const DefaultKDFIterations = 600000
+
+// MaxKDFIterations is the highest PBKDF2 iteration count accepted, so that a
+// tampered envelope cannot tie up the CPU before authentication fails.
+const MaxKDFIterations = 10 * DefaultKDFIterations
+
+// ErrDecrypt is returned when ciphertext cannot be authenticated with the given key.
+var ErrDecrypt = errors.New("decrypt: message authentication failed")
+
+// EncryptOptions contains options for client-side encryption.
+type EncryptOptions struct {
+	// Passphrase, when set, is mixed into the key so that the share URL
+	// alone is not enough to decrypt the content.
+	Passphrase string
+	// Iterations is the PBKDF2 iteration count (default: DefaultKDFIterations,
+	// at most MaxKDFIterations).
+	Iterations int
+}
+
+// EncryptedTextResponse represents the result of creating an encrypted text.
+type EncryptedTextResponse struct {
+	*CreateTextResponse
+	// ShareURL is the short URL with the decryption key in its fragment.
+	// The fragment is never sent to the server by browsers or this SDK.
+	ShareURL string
+	// Key is the base64url-encoded random key embedded in ShareURL.
+	Key string
+}
+
+// encryptedEnvelope is the versioned payload stored as the text content.
+type encryptedEnvelope struct {
+	Version    int    `json:"v"`
+	Algorithm  string `json:"alg"`
+	KDF        string `json:"kdf,omitempty"`
+	Iterations int    `json:"iter,omitempty"`
+	Salt       string `json:"salt,omitempty"`
+	Nonce      string `json:"nonce"`
+	Ciphertext string `json:"ct"`
+}
+
+// additionalData binds the envelope header to the ciphertext.
+func (e *encryptedEnvelope) additionalData() []byte {
+	return []byte("see:" + strconv.Itoa(e.Version) + ":" + e.Algorithm + ":" +
+		e.KDF + ":" + strconv.Itoa(e.Iterations) + ":" + e.Salt)
+}
+
+// CreateEncryptedText encrypts req.Content locally with AES-256-GCM and
+// creates a text holding only the ciphertext. The returned ShareURL carries
+// the key in its fragment. Title and other metadata are not encrypted.
+func (c *Client) CreateEncryptedText(req CreateTextRequest, opts EncryptOptions) (*EncryptedTextResponse, error) {
+	key, err := newEncryptionKey()
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := encryptText(req.Content, key, opts)
+	if err != nil {
+		return nil, err
+	}
+	req.Content = content
+
+	response, err := c.CreateText(req)
+	if err != nil {
+		return nil, err
+	}
+
+	encodedKey := base64.RawURLEncoding.EncodeToString(key)
+	return &EncryptedTextResponse{
+		CreateTextResponse: response,
+		ShareURL:           response.Data.ShortURL + "#" + encodedKey,
+		Key:                encodedKey,
+	}, nil
+}
+
+// EncryptText encrypts plaintext with a new random key and returns the
+// envelope to be stored as text content together with the base64url key.
+func EncryptText(plaintext string, opts EncryptOptions) (content, key string, err error) {
+	rawKey, err := newEncryptionKey()
+	if err != nil {
+		return "", "", err
+	}
+	content, err = encryptText(plaintext, rawKey, opts)
+	if err != nil {
+		return "", "", err
+	}
+	return content, base64.RawURLEncoding.EncodeToString(rawKey), nil
+}
+
+// DecryptText decrypts the content of an encrypted text using the key from
+// the fragment of shareURL. The passphrase must match the one used for
+// encryption, or be empty if none was used.
+func DecryptText(shareURL, content, passphrase string) (string, error) {
+	key, err := KeyFromURL(shareURL)
+	if err != nil {
+		return "", err
+	}
+	return decryptText(content, key, passphrase)
+}
+
+// KeyFromURL extracts the base64url-encoded encryption key from the fragment of a share URL.
+func KeyFromURL(shareURL string) ([]byte, error) {
+	u, err := url.Parse(shareURL)
+	if err != nil {
+		return nil, fmt.Errorf("parse share URL: %w", err)
+	}
+	if u.Fragment == "" {
+		return nil, fmt.Errorf("share URL has no key fragment")
+	}
+	key, err := base64.RawURLEncoding.DecodeString(u.Fragment)
+	if err != nil {
+		return nil, fmt.Errorf("decode key: %w", err)
+	}
+	if len(key) != encryptionKeySize {
+		return nil, fmt.Errorf("invalid key length %d", len(key))
+	}
+	return key, nil
+}
+
+func encryptText(plaintext string, key []byte, opts EncryptOptions) (string, error) {
+	env := encryptedEnvelope{
+		Version:   encryptionVersion,
+		Algorithm: encryptionAlgorithm,
+	}
+
+	var salt []byte
+	if opts.Passphrase != "" {
+		env.KDF = kdfPBKDF2SHA256
+		env.Iterations = opts.Iterations
+		if env.Iterations <= 0 {
+			env.Iterations = DefaultKDFIterations
+		}
+		salt = make([]byte, encryptionSaltSize)
+		if _, err := rand.Read(salt); err != nil {
+			return "", fmt.Errorf("generate salt: %w", err)
+		}
+		env.Salt = base64.RawStdEncoding.EncodeToString(salt)
+	}
+
+	aead, err := newEnvelopeAEAD(&env, key, salt, opts.Passphrase)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("generate nonce: %w", err)
+	}
+	env.Nonce = base64.RawStdEncoding.EncodeToString(nonce)
+	env.Ciphertext = base64.RawStdEncoding.EncodeToString(
+		aead.Seal(nil, nonce, []byte(plaintext), env.additionalData()))
+
+	data, err := json.Marshal(env)
+	if err != nil {
+		return "", fmt.Errorf("marshal envelope: %w", err)
+	}
+	return string(data), nil
+}
+
+func decryptText(content string, key []byte, passphrase string) (string, error) {
+	var env encryptedEnvelope
+	if err := json.Unmarshal([]byte(content), &env); err != nil {
+		return "", fmt.Errorf("unmarshal envelope: %w", err)
+	}
+	if env.Version != encryptionVersion {
+		return "", fmt.Errorf("unsupported envelope version %d", env.Version)
+	}
+	if env.Algorithm != encryptionAlgorithm {
+		return "", fmt.Errorf("unsupported algorithm %q", env.Algorithm)
+	}
+
+	var salt []byte
+	switch env.KDF {
+	case "":
+		if passphrase != "" {
+			return "", fmt.Errorf("text is not passphrase protected")
+		}
+	case kdfPBKDF2SHA256:
+		if passphrase == "" {
+			return "", fmt.Errorf("text is passphrase protected")
+		}
+		var err error
+		if salt, err = base64.RawStdEncoding.DecodeString(env.Salt); err != nil {
+			return "", fmt.Errorf("decode salt: %w", err)
+		}
+	default:
+		return "", fmt.Errorf("unsupported key derivation %q", env.KDF)
+	}
+
+	nonce, err := base64.RawStdEncoding.DecodeString(env.Nonce)
+	if err != nil {
+		return "", fmt.Errorf("decode nonce: %w", err)
+	}
+	ciphertext, err := base64.RawStdEncoding.DecodeString(env.Ciphertext)
+	if err != nil {
+		return "", fmt.Errorf("decode ciphertext: %w", err)
+	}
+
+	aead, err := newEnvelopeAEAD(&env, key, salt, passphrase)
+	if err != nil {
+		return "", err
+	}
+	if len(nonce) != aead.NonceSize() {
+		return "", fmt.Errorf("invalid nonce length %d", len(nonce))
+	}
+
+	plaintext, err := aead.Open(nil, nonce, ciphertext, env.additionalData())
+	if err != nil {
+		return "", ErrDecrypt
+	}
+	return string(plaintext), nil
+}
+
+// newEnvelopeAEAD returns the AEAD for an envelope, deriving the content key
+// from the URL key and passphrase when the envelope uses a KDF.
+func newEnvelopeAEAD(env *encryptedEnvelope, key, salt []byte, passphrase string) (cipher.AEAD, error) {
+	if env.KDF == kdfPBKDF2SHA256 {
+		if env.Iterations <= 0 || env.Iterations > MaxKDFIterations {
+			return nil, fmt.Errorf("invalid iteration count %d", env.Iterations)
+		}
+		secret := append(append([]byte{}, key...), passphrase...)
+		key = pbkdf2(sha256.New, secret, salt, env.Iterations, encryptionKeySize)
+	}
+	return newGCM(key)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("create cipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("create GCM: %w", err)
+	}
+	return aead, nil
+}
+
+func newEncryptionKey() ([]byte, error) {
+	key := make([]byte, encryptionKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("generate key: %w", err)
+	}
+	return key, nil
+}
+
+// pbkdf2 implements PBKDF2 (RFC 8018) with the given HMAC hash.
+func pbkdf2(h func() hash.Hash, password, salt []byte, iter, keyLen int) []byte {
+	prf := hmac.New(h, password)
+	hashLen := prf.Size()
+	numBlocks := (keyLen + hashLen - 1) / hashLen
+
+	var buf [4]byte
+	dk := make([]byte, 0, numBlocks*hashLen)
+	u := make([]byte, hashLen)
+	for block := 1; block <= numBlocks; block++ {
+		prf.Reset()
+		prf.Write(salt)
+		binary.BigEndian.PutUint32(buf[:], uint32(block))
+		prf.Write(buf[:4])
+		dk = prf.Sum(dk)
+		t := dk[len(dk)-hashLen:]
+		copy(u, t)
+
+		for n := 2; n <= iter; n++ {
+			prf.Reset()
+			prf.Write(u)
+			u = u[:0]
+			u = prf.Sum(u)
+			for i := range u {
+				t[i] ^= u[i]
+			}
+		}
+	}
+	return dk[:keyLen]
+}
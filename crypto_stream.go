@@ -0,0 +1,312 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: crypto_stream.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:20:10
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 05:14:09
+//
+
+package seesdk
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// DefaultEncryptChunkSize is the plaintext size of each encrypted chunk.
+const DefaultEncryptChunkSize = 64 * 1024
+
+// MaxEncryptedUploadSize is the largest file UploadEncryptedFile accepts:
+// its ciphertext, including chunk tags and header, is exactly the upload limit.
+const MaxEncryptedUploadSize = maxUploadSize - maxUploadSize/DefaultEncryptChunkSize*streamTagSize - int64(streamHeaderSize)
+
+const (
+	streamMagic        = "SEEF"
+	streamVersion      = 1
+	streamSaltSize     = 16
+	streamHeaderSize   = len(streamMagic) + 1 + 4 + streamSaltSize
+	streamMaxChunkSize = 16 * 1024 * 1024
+	streamTagSize      = 16
+)
+
+// EncryptedUploadResponse represents the result of uploading an encrypted file.
+type EncryptedUploadResponse struct {
+	*UploadFileResponse
+	// ShareURL is the file URL with the decryption key in its fragment.
+	ShareURL string
+	// Key is the base64url-encoded key needed to decrypt the file.
+	Key string
+}
+
+// UploadEncryptedFile encrypts file while streaming it to the server, so the
+// server only ever stores ciphertext. Use NewDecryptReader with the returned
+// key to decrypt the downloaded content. Files larger than
+// MaxEncryptedUploadSize do not fit the upload limit once encrypted.
+func (c *Client) UploadEncryptedFile(filename string, file io.Reader) (*EncryptedUploadResponse, error) {
+	if file == nil {
+		return nil, fmt.Errorf("file is nil")
+	}
+	if size, ok := readerSize(file); ok && EncryptedSize(size) > maxUploadSize {
+		return nil, fmt.Errorf("file size exceeds the limit of %d bytes for encrypted uploads", MaxEncryptedUploadSize)
+	}
+
+	key, err := newEncryptionKey()
+	if err != nil {
+		return nil, err
+	}
+
+	encrypted, err := NewEncryptReader(file, key)
+	if err != nil {
+		return nil, err
+	}
+
+	// Stop streaming as soon as a file of unknown size turns out too large.
+	body := &maxSizeReader{r: encrypted, limit: maxUploadSize, remaining: maxUploadSize}
+	response, err := c.UploadFile(filename, body)
+	if err != nil {
+		return nil, err
+	}
+
+	encodedKey := base64.RawURLEncoding.EncodeToString(key)
+	return &EncryptedUploadResponse{
+		UploadFileResponse: response,
+		ShareURL:           response.Data.URL + "#" + encodedKey,
+		Key:                encodedKey,
+	}, nil
+}
+
+// EncryptedSize returns the size of the encrypted stream for a plaintext of
+// the given size using DefaultEncryptChunkSize.
+func EncryptedSize(plaintextSize int64) int64 {
+	chunks := (plaintextSize + DefaultEncryptChunkSize - 1) / DefaultEncryptChunkSize
+	if chunks == 0 {
+		chunks = 1
+	}
+	return int64(streamHeaderSize) + plaintextSize + chunks*streamTagSize
+}
+
+// encryptReader encrypts a stream in fixed size chunks. Each chunk is sealed
+// with a nonce derived from its index and a flag marking the final chunk, so
+// reordering and truncation are detected on decryption.
+type encryptReader struct {
+	src       *bufio.Reader
+	aead      cipher.AEAD
+	header    []byte
+	chunkSize int
+	counter   uint32
+	buf       []byte
+	sealed    []byte
+	out       []byte
+	done      bool
+	err       error
+}
+
+// NewEncryptReader returns a reader that yields the encrypted form of r
+// using the given 32-byte key.
+func NewEncryptReader(r io.Reader, key []byte) (io.Reader, error) {
+	if len(key) != encryptionKeySize {
+		return nil, fmt.Errorf("invalid key length %d", len(key))
+	}
+
+	salt := make([]byte, streamSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("generate salt: %w", err)
+	}
+
+	header := make([]byte, 0, streamHeaderSize)
+	header = append(header, streamMagic...)
+	header = append(header, streamVersion)
+	header = binary.BigEndian.AppendUint32(header, DefaultEncryptChunkSize)
+	header = append(header, salt...)
+
+	aead, err := newGCM(streamKey(key, salt))
+	if err != nil {
+		return nil, err
+	}
+
+	return &encryptReader{
+		src:       bufio.NewReaderSize(r, DefaultEncryptChunkSize),
+		aead:      aead,
+		header:    header,
+		chunkSize: DefaultEncryptChunkSize,
+		buf:       make([]byte, DefaultEncryptChunkSize),
+		sealed:    make([]byte, 0, DefaultEncryptChunkSize+streamTagSize),
+		out:       header,
+	}, nil
+}
+
+func (e *encryptReader) Read(p []byte) (int, error) {
+	for len(e.out) == 0 {
+		if e.err != nil {
+			return 0, e.err
+		}
+		if e.done {
+			return 0, io.EOF
+		}
+		e.sealNext()
+	}
+
+	n := copy(p, e.out)
+	e.out = e.out[n:]
+	return n, nil
+}
+
+func (e *encryptReader) sealNext() {
+	n, err := io.ReadFull(e.src, e.buf)
+	last := false
+	switch {
+	case err == io.EOF || err == io.ErrUnexpectedEOF:
+		last = true
+	case err != nil:
+		e.err = err
+		return
+	default:
+		if _, err := e.src.Peek(1); err == io.EOF {
+			last = true
+		} else if err != nil {
+			e.err = err
+			return
+		}
+	}
+
+	if e.counter == ^uint32(0) {
+		e.err = fmt.Errorf("encrypt: stream too large")
+		return
+	}
+	e.sealed = e.aead.Seal(e.sealed[:0], streamNonce(e.counter, last), e.buf[:n], e.header)
+	e.out = e.sealed
+	e.counter++
+	e.done = last
+}
+
+// decryptReader reverses encryptReader.
+type decryptReader struct {
+	src       *bufio.Reader
+	aead      cipher.AEAD
+	header    []byte
+	chunkSize int
+	counter   uint32
+	buf       []byte
+	out       []byte
+	done      bool
+	err       error
+}
+
+// NewDecryptReader returns a reader that decrypts a stream produced by
+// NewEncryptReader or UploadEncryptedFile. Reads fail with ErrDecrypt if the
+// stream was modified, reordered or truncated.
+func NewDecryptReader(r io.Reader, key []byte) (io.Reader, error) {
+	if len(key) != encryptionKeySize {
+		return nil, fmt.Errorf("invalid key length %d", len(key))
+	}
+
+	header := make([]byte, streamHeaderSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, fmt.Errorf("read header: %w", err)
+	}
+	if !bytes.Equal(header[:len(streamMagic)], []byte(streamMagic)) {
+		return nil, fmt.Errorf("not an encrypted stream")
+	}
+	if v := header[len(streamMagic)]; v != streamVersion {
+		return nil, fmt.Errorf("unsupported stream version %d", v)
+	}
+	chunkSize := int(binary.BigEndian.Uint32(header[len(streamMagic)+1:]))
+	if chunkSize <= 0 || chunkSize > streamMaxChunkSize {
+		return nil, fmt.Errorf("invalid chunk size %d", chunkSize)
+	}
+	salt := header[streamHeaderSize-streamSaltSize:]
+
+	aead, err := newGCM(streamKey(key, salt))
+	if err != nil {
+		return nil, err
+	}
+
+	return &decryptReader{
+		src:       bufio.NewReaderSize(r, chunkSize+streamTagSize),
+		aead:      aead,
+		header:    header,
+		chunkSize: chunkSize,
+		buf:       make([]byte, chunkSize+streamTagSize),
+	}, nil
+}
+
+func (d *decryptReader) Read(p []byte) (int, error) {
+	for len(d.out) == 0 {
+		if d.err != nil {
+			return 0, d.err
+		}
+		if d.done {
+			return 0, io.EOF
+		}
+		d.openNext()
+	}
+
+	n := copy(p, d.out)
+	d.out = d.out[n:]
+	return n, nil
+}
+
+func (d *decryptReader) openNext() {
+	n, err := io.ReadFull(d.src, d.buf)
+	last := false
+	switch {
+	case err == io.EOF:
+		// The final chunk always carries a tag, so a clean EOF here means
+		// the stream was cut at a chunk boundary.
+		d.err = ErrDecrypt
+		return
+	case err == io.ErrUnexpectedEOF:
+		last = true
+	case err != nil:
+		d.err = err
+		return
+	default:
+		if _, err := d.src.Peek(1); err == io.EOF {
+			last = true
+		} else if err != nil {
+			d.err = err
+			return
+		}
+	}
+
+	plaintext, err := d.aead.Open(d.buf[:0], streamNonce(d.counter, last), d.buf[:n], d.header)
+	if err != nil {
+		d.err = ErrDecrypt
+		return
+	}
+	d.out = plaintext
+	d.counter++
+	d.done = last
+}
+
+// streamKey derives a per-stream key so that nonces never repeat across
+// streams encrypted with the same key.
+func streamKey(key, salt []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte("see:file:v1:"))
+	mac.Write(salt)
+	return mac.Sum(nil)
+}
+
+func streamNonce(counter uint32, last bool) []byte {
+	nonce := make([]byte, 12)
+	binary.BigEndian.PutUint32(nonce[7:11], counter)
+	if last {
+		nonce[11] = 1
+	}
+	return nonce
+}
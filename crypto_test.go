@@ -0,0 +1,218 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: crypto_test.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:18:11
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 05:14:32
+//
+
+package seesdk
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPBKDF2(t *testing.T) {
+	tests := []struct {
+		iter int
+		want string
+	}{
+		{1, "120fb6cffcf8b32c43e7225256c4f837a86548c92ccc35480805987cb70be17b"},
+		{2, "ae4d0c95af6b46d32d0adff928f06dd02a303f8ef3c251dfd6e2d85a95474c43"},
+	}
+
+	for _, tt := range tests {
+		got := hex.EncodeToString(pbkdf2(sha256.New, []byte("password"), []byte("salt"), tt.iter, 32))
+		if got != tt.want {
+			t.Errorf("pbkdf2 iter=%d: expected %s, got %s", tt.iter, tt.want, got)
+		}
+	}
+}
+
+func TestEncryptText(t *testing.T) {
+	plaintext := "top secret: hunter2"
+
+	content, key, err := EncryptText(plaintext, EncryptOptions{})
+	if err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	if strings.Contains(content, "hunter2") {
+		t.Fatal("Expected content to not contain plaintext")
+	}
+
+	shareURL := "https://ba.sh/abc#" + key
+	got, err := DecryptText(shareURL, content, "")
+	if err != nil {
+		t.Fatal("Expected no error on decrypt, got:", err)
+	}
+	if got != plaintext {
+		t.Errorf("Expected %q, got %q", plaintext, got)
+	}
+
+	tampered := strings.Replace(content, `"v":1`, `"v":2`, 1)
+	if _, err := DecryptText(shareURL, tampered, ""); err == nil {
+		t.Error("Expected error for unsupported version")
+	}
+
+	_, otherKey, _ := EncryptText("other", EncryptOptions{})
+	if _, err := DecryptText("https://ba.sh/abc#"+otherKey, content, ""); !errors.Is(err, ErrDecrypt) {
+		t.Errorf("Expected ErrDecrypt with wrong key, got: %v", err)
+	}
+}
+
+func TestEncryptTextPassphrase(t *testing.T) {
+	opts := EncryptOptions{Passphrase: "correct horse", Iterations: 1000}
+
+	content, key, err := EncryptText("hello", opts)
+	if err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+
+	shareURL := "https://ba.sh/abc#" + key
+	got, err := DecryptText(shareURL, content, "correct horse")
+	if err != nil {
+		t.Fatal("Expected no error on decrypt, got:", err)
+	}
+	if got != "hello" {
+		t.Errorf("Expected %q, got %q", "hello", got)
+	}
+
+	if _, err := DecryptText(shareURL, content, "wrong"); !errors.Is(err, ErrDecrypt) {
+		t.Errorf("Expected ErrDecrypt with wrong passphrase, got: %v", err)
+	}
+	if _, err := DecryptText(shareURL, content, ""); err == nil {
+		t.Error("Expected error without passphrase")
+	}
+}
+
+func TestDecryptTextIterationLimit(t *testing.T) {
+	if _, _, err := EncryptText("hello", EncryptOptions{Passphrase: "pw", Iterations: MaxKDFIterations + 1}); err == nil {
+		t.Error("Expected error for too many iterations")
+	}
+
+	content, key, err := EncryptText("hello", EncryptOptions{Passphrase: "pw", Iterations: 1000})
+	if err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	tampered := strings.Replace(content, `"iter":1000`, `"iter":2147483647`, 1)
+	if tampered == content {
+		t.Fatal("Expected envelope to contain the iteration count")
+	}
+
+	start := time.Now()
+	if _, err := DecryptText("https://ba.sh/abc#"+key, tampered, "pw"); err == nil {
+		t.Error("Expected error for a tampered iteration count")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected tampered envelope to be rejected before key derivation, took %s", elapsed)
+	}
+}
+
+func TestKeyFromURL(t *testing.T) {
+	if _, err := KeyFromURL("https://ba.sh/abc"); err == nil {
+		t.Error("Expected error for URL without fragment")
+	}
+	if _, err := KeyFromURL("https://ba.sh/abc#c2hvcnQ"); err == nil {
+		t.Error("Expected error for short key")
+	}
+}
+
+func TestEncryptReader(t *testing.T) {
+	key, err := newEncryptionKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, size := range []int{0, 1, DefaultEncryptChunkSize, DefaultEncryptChunkSize + 1, 3*DefaultEncryptChunkSize + 17} {
+		plaintext := make([]byte, size)
+		for i := range plaintext {
+			plaintext[i] = byte(i * 7)
+		}
+
+		enc, err := NewEncryptReader(bytes.NewReader(plaintext), key)
+		if err != nil {
+			t.Fatal("Expected no error, got:", err)
+		}
+		ciphertext, err := io.ReadAll(enc)
+		if err != nil {
+			t.Fatal("Expected no error on encrypt, got:", err)
+		}
+		if int64(len(ciphertext)) != EncryptedSize(int64(size)) {
+			t.Errorf("size %d: expected encrypted size %d, got %d", size, EncryptedSize(int64(size)), len(ciphertext))
+		}
+
+		dec, err := NewDecryptReader(bytes.NewReader(ciphertext), key)
+		if err != nil {
+			t.Fatal("Expected no error, got:", err)
+		}
+		got, err := io.ReadAll(dec)
+		if err != nil {
+			t.Fatalf("size %d: expected no error on decrypt, got: %v", size, err)
+		}
+		if !bytes.Equal(got, plaintext) {
+			t.Errorf("size %d: decrypted content mismatch", size)
+		}
+	}
+}
+
+// sizedReader reports a size without holding the content.
+type sizedReader struct{ size int }
+
+func (r sizedReader) Read([]byte) (int, error) { return 0, io.EOF }
+func (r sizedReader) Len() int                 { return r.size }
+
+func TestUploadEncryptedFileSizeLimit(t *testing.T) {
+	if EncryptedSize(MaxEncryptedUploadSize) != maxUploadSize {
+		t.Errorf("Expected MaxEncryptedUploadSize to encrypt to %d bytes, got %d",
+			maxUploadSize, EncryptedSize(MaxEncryptedUploadSize))
+	}
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{BaseURL: server.URL})
+	for _, size := range []int{maxUploadSize, int(MaxEncryptedUploadSize) + 1} {
+		if _, err := client.UploadEncryptedFile("big.bin", sizedReader{size}); err == nil {
+			t.Errorf("size %d: expected size limit error", size)
+		}
+	}
+	if requests != 0 {
+		t.Errorf("Expected no upload requests, got %d", requests)
+	}
+}
+
+func TestDecryptReaderTruncated(t *testing.T) {
+	key, _ := newEncryptionKey()
+	plaintext := make([]byte, 2*DefaultEncryptChunkSize+5)
+
+	enc, _ := NewEncryptReader(bytes.NewReader(plaintext), key)
+	ciphertext, _ := io.ReadAll(enc)
+
+	// Drop the final chunk so the stream ends on a chunk boundary.
+	truncated := ciphertext[:streamHeaderSize+2*(DefaultEncryptChunkSize+streamTagSize)]
+	dec, err := NewDecryptReader(bytes.NewReader(truncated), key)
+	if err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	if _, err := io.ReadAll(dec); !errors.Is(err, ErrDecrypt) {
+		t.Errorf("Expected ErrDecrypt for truncated stream, got: %v", err)
+	}
+}
@@ -0,0 +1,300 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: custom_domains.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:35:20
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 05:01:05
+//
+
+package seesdk
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DefaultDomainPollInterval is the default interval between verification
+// status checks in WaitForDomainVerification.
+const DefaultDomainPollInterval = 30 * time.Second
+
+// AddCustomDomain registers a custom domain. The response lists the DNS
+// records that must be created before the domain can be verified.
+func (c *Client) AddCustomDomain(req AddCustomDomainRequest) (*CustomDomainResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	respBody, err := c.doRequest("POST", "/domains/custom", req)
+	if err != nil {
+		return nil, err
+	}
+	c.DomainCatalog().Invalidate()
+
+	var response CustomDomainResponse
+	if err := unmarshalResponse(respBody, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// GetCustomDomain retrieves the details and verification status of a custom domain.
+func (c *Client) GetCustomDomain(domain string) (*CustomDomainResponse, error) {
+	return c.getCustomDomain(context.Background(), domain)
+}
+
+func (c *Client) getCustomDomain(ctx context.Context, domain string) (*CustomDomainResponse, error) {
+	respBody, err := c.doRequestContext(ctx, "GET", "/domains/custom?"+domainQuery(domain), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response CustomDomainResponse
+	if err := unmarshalResponse(respBody, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// GetDomainDNSRecords retrieves the DNS records required to verify a custom domain.
+func (c *Client) GetDomainDNSRecords(domain string) (*DNSRecordsResponse, error) {
+	return c.getDomainDNSRecords(context.Background(), domain)
+}
+
+func (c *Client) getDomainDNSRecords(ctx context.Context, domain string) (*DNSRecordsResponse, error) {
+	respBody, err := c.doRequestContext(ctx, "GET", "/domains/custom/records?"+domainQuery(domain), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response DNSRecordsResponse
+	if err := unmarshalResponse(respBody, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// VerifyCustomDomain asks the server to check the DNS records of a custom
+// domain now instead of waiting for its periodic check.
+func (c *Client) VerifyCustomDomain(domain string) (*CustomDomainResponse, error) {
+	return c.verifyCustomDomain(context.Background(), domain)
+}
+
+func (c *Client) verifyCustomDomain(ctx context.Context, domain string) (*CustomDomainResponse, error) {
+	respBody, err := c.doRequestContext(ctx, "POST", "/domains/custom/verify", AddCustomDomainRequest{Domain: domain}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response CustomDomainResponse
+	if err := unmarshalResponse(respBody, &response); err != nil {
+		return nil, err
+	}
+	if response.Data.Status == CustomDomainVerified {
+		c.DomainCatalog().Invalidate()
+	}
+
+	return &response, nil
+}
+
+// WaitForDomainVerification polls the status of a custom domain every
+// interval (default: DefaultDomainPollInterval) until it is verified or
+// verification fails, or ctx is done.
+func (c *Client) WaitForDomainVerification(ctx context.Context, domain string, interval time.Duration) (*CustomDomain, error) {
+	if interval <= 0 {
+		interval = DefaultDomainPollInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		resp, err := c.getCustomDomain(ctx, domain)
+		if err != nil {
+			return nil, err
+		}
+		switch resp.Data.Status {
+		case CustomDomainVerified:
+			c.DomainCatalog().Invalidate()
+			return &resp.Data, nil
+		case CustomDomainFailed:
+			return &resp.Data, fmt.Errorf("verification of %s failed", domain)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// SetDomainFallback sets the URL that visitors of unknown or expired slugs
+// on a custom domain are redirected to.
+func (c *Client) SetDomainFallback(req SetDomainFallbackRequest) (*CustomDomainResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	respBody, err := c.doRequest("PUT", "/domains/custom/fallback", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var response CustomDomainResponse
+	if err := unmarshalResponse(respBody, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// RemoveCustomDomain removes a custom domain. Links on the domain stop resolving.
+func (c *Client) RemoveCustomDomain(req RemoveCustomDomainRequest) (*RemoveCustomDomainResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	respBody, err := c.doRequest("DELETE", "/domains/custom", req)
+	if err != nil {
+		return nil, err
+	}
+	c.DomainCatalog().Invalidate()
+
+	var response RemoveCustomDomainResponse
+	if err := unmarshalResponse(respBody, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// CheckAndVerifyCustomDomain fetches the DNS records of a custom domain and
+// checks them with resolver before asking the server to verify the domain.
+// If any record is missing, a *DNSCheckError is returned and the server is
+// not contacted again. A nil resolver uses net.DefaultResolver.
+func (c *Client) CheckAndVerifyCustomDomain(ctx context.Context, domain string, resolver DNSResolver) (*CustomDomainResponse, error) {
+	records, err := c.getDomainDNSRecords(ctx, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	var failed []DNSCheckResult
+	for _, result := range CheckDNSRecords(ctx, resolver, records.Data.Records) {
+		if !result.OK {
+			failed = append(failed, result)
+		}
+	}
+	if len(failed) > 0 {
+		return nil, &DNSCheckError{Domain: domain, Failed: failed}
+	}
+
+	return c.verifyCustomDomain(ctx, domain)
+}
+
+// DNSResolver looks up DNS records. *net.Resolver satisfies it.
+type DNSResolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+	LookupCNAME(ctx context.Context, host string) (string, error)
+	LookupHost(ctx context.Context, host string) ([]string, error)
+}
+
+// NewDNSResolver returns a resolver that sends all queries to nameserver
+// ("host:port", port 53 if omitted) instead of the system resolver.
+func NewDNSResolver(nameserver string) *net.Resolver {
+	if _, _, err := net.SplitHostPort(nameserver); err != nil {
+		nameserver = net.JoinHostPort(nameserver, "53")
+	}
+	return &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, network, nameserver)
+		},
+	}
+}
+
+// DNSCheckResult is the outcome of checking one DNS record.
+type DNSCheckResult struct {
+	Record DNSRecord
+	Found  []string // Values found for the record name
+	OK     bool
+	Err    error // Lookup error, if any
+}
+
+// DNSCheckError is returned when required DNS records are not in place.
+type DNSCheckError struct {
+	Domain string
+	Failed []DNSCheckResult
+}
+
+func (e *DNSCheckError) Error() string {
+	records := make([]string, len(e.Failed))
+	for i, r := range e.Failed {
+		records[i] = r.Record.Type + " " + r.Record.Name
+	}
+	return fmt.Sprintf("DNS records for %s not found: %s", e.Domain, strings.Join(records, ", "))
+}
+
+// CheckDNSRecords looks up each record with resolver and reports whether
+// the expected value is present. A nil resolver uses net.DefaultResolver.
+func CheckDNSRecords(ctx context.Context, resolver DNSResolver, records []DNSRecord) []DNSCheckResult {
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+
+	results := make([]DNSCheckResult, len(records))
+	for i, record := range records {
+		result := DNSCheckResult{Record: record}
+		switch strings.ToUpper(record.Type) {
+		case "TXT":
+			result.Found, result.Err = resolver.LookupTXT(ctx, record.Name)
+		case "CNAME":
+			var cname string
+			cname, result.Err = resolver.LookupCNAME(ctx, record.Name)
+			if cname != "" {
+				result.Found = []string{cname}
+			}
+		case "A", "AAAA":
+			result.Found, result.Err = resolver.LookupHost(ctx, record.Name)
+		default:
+			result.Err = fmt.Errorf("unsupported record type %q", record.Type)
+		}
+		for _, value := range result.Found {
+			if dnsValueEqual(record.Type, value, record.Value) {
+				result.OK = true
+				break
+			}
+		}
+		results[i] = result
+	}
+	return results
+}
+
+// dnsValueEqual compares record values, ignoring case and the trailing dot
+// of host names.
+func dnsValueEqual(recordType, found, want string) bool {
+	if strings.EqualFold(recordType, "TXT") {
+		return found == want
+	}
+	if ip := net.ParseIP(want); ip != nil {
+		return ip.Equal(net.ParseIP(found))
+	}
+	return strings.EqualFold(strings.TrimSuffix(found, "."), strings.TrimSuffix(want, "."))
+}
+
+// domainQuery encodes domain as a query parameter.
+func domainQuery(domain string) string {
+	return url.Values{"domain": {domain}}.Encode()
+}
@@ -0,0 +1,173 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: custom_domains_test.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:35:33
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 05:01:05
+//
+
+package seesdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type fakeResolver struct {
+	txt   map[string][]string
+	cname map[string]string
+	hosts map[string][]string
+}
+
+func (r fakeResolver) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	if v, ok := r.txt[name]; ok {
+		return v, nil
+	}
+	return nil, errors.New("no such host")
+}
+
+func (r fakeResolver) LookupCNAME(ctx context.Context, host string) (string, error) {
+	if v, ok := r.cname[host]; ok {
+		return v, nil
+	}
+	return "", errors.New("no such host")
+}
+
+func (r fakeResolver) LookupHost(ctx context.Context, host string) ([]string, error) {
+	if v, ok := r.hosts[host]; ok {
+		return v, nil
+	}
+	return nil, errors.New("no such host")
+}
+
+func TestCheckDNSRecords(t *testing.T) {
+	resolver := fakeResolver{
+		txt:   map[string][]string{"_see.example.com": {"other", "see-verify=abc"}},
+		cname: map[string]string{"go.example.com": "CNAME.S.EE."},
+		hosts: map[string][]string{"example.com": {"203.0.113.7"}},
+	}
+	records := []DNSRecord{
+		{Type: "TXT", Name: "_see.example.com", Value: "see-verify=abc"},
+		{Type: "CNAME", Name: "go.example.com", Value: "cname.s.ee"},
+		{Type: "A", Name: "example.com", Value: "203.0.113.8"},
+		{Type: "TXT", Name: "_missing.example.com", Value: "x"},
+	}
+
+	results := CheckDNSRecords(context.Background(), resolver, records)
+	want := []bool{true, true, false, false}
+	for i, result := range results {
+		if result.OK != want[i] {
+			t.Errorf("Record %d: expected OK %v, got %v (found %v)", i, want[i], result.OK, result.Found)
+		}
+	}
+	if results[3].Err == nil {
+		t.Error("Expected lookup error for missing record")
+	}
+}
+
+func TestCheckAndVerifyCustomDomain(t *testing.T) {
+	verifyRequests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/domains/custom/records":
+			w.Write([]byte(`{"code":200,"data":{"records":[{"type":"TXT","name":"_see.example.com","value":"see-verify=abc"}]}}`))
+		case "/domains/custom/verify":
+			verifyRequests++
+			w.Write([]byte(`{"code":200,"data":{"domain":"example.com","status":"verified"}}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{BaseURL: server.URL})
+	ctx := context.Background()
+
+	_, err := client.CheckAndVerifyCustomDomain(ctx, "example.com", fakeResolver{})
+	var dnsErr *DNSCheckError
+	if !errors.As(err, &dnsErr) || len(dnsErr.Failed) != 1 {
+		t.Fatalf("Expected DNSCheckError, got: %v", err)
+	}
+	if verifyRequests != 0 {
+		t.Error("Expected no verification request when records are missing")
+	}
+
+	resolver := fakeResolver{txt: map[string][]string{"_see.example.com": {"see-verify=abc"}}}
+	resp, err := client.CheckAndVerifyCustomDomain(ctx, "example.com", resolver)
+	if err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	if resp.Data.Status != CustomDomainVerified {
+		t.Errorf("Expected verified status, got %s", resp.Data.Status)
+	}
+}
+
+func TestWaitForDomainVerification(t *testing.T) {
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		if r.URL.Query().Get("domain") != "example.com" {
+			t.Errorf("Expected domain example.com, got %s", r.URL.RawQuery)
+		}
+		status := "pending"
+		if polls == 3 {
+			status = "verified"
+		}
+		w.Write([]byte(`{"code":200,"data":{"domain":"example.com","status":"` + status + `"}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{BaseURL: server.URL})
+	domain, err := client.WaitForDomainVerification(context.Background(), "example.com", time.Millisecond)
+	if err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	if domain.Status != CustomDomainVerified || polls != 3 {
+		t.Errorf("Expected verified after 3 polls, got %s after %d", domain.Status, polls)
+	}
+}
+
+func TestWaitForDomainVerificationCancelsRequest(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	client := NewClient(Config{BaseURL: server.URL})
+	start := time.Now()
+	_, err := client.WaitForDomainVerification(ctx, "example.com", time.Millisecond)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context deadline error, got: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Expected the pending request to be cancelled, took %s", elapsed)
+	}
+}
+
+func TestAddCustomDomainRequestValidate(t *testing.T) {
+	for domain, valid := range map[string]bool{
+		"links.example.com": true,
+		"example":           false,
+		"bad_host.com":      false,
+		"-a.example.com":    false,
+		"":                  false,
+	} {
+		err := AddCustomDomainRequest{Domain: domain}.Validate()
+		if (err == nil) != valid {
+			t.Errorf("%q: expected valid %v, got: %v", domain, valid, err)
+		}
+	}
+}
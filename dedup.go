@@ -0,0 +1,323 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: dedup.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:44:40
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 04:44:40
+//
+
+package seesdk
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultDedupCacheSize is the default number of short URLs kept in memory.
+	DefaultDedupCacheSize = 10000
+	// DefaultDedupExpiryTolerance is how much earlier than requested a
+	// cached short URL may expire and still be reused.
+	DefaultDedupExpiryTolerance = time.Hour
+)
+
+// StoredShortURL is a short URL remembered by a ShortURLDeduper.
+type StoredShortURL struct {
+	Response  CreateShortURLResponse `json:"response"`
+	ExpireAt  Expiry                 `json:"expire_at,omitempty"`
+	CreatedAt time.Time              `json:"created_at"`
+}
+
+// ShortURLStore persists short URLs remembered by a ShortURLDeduper.
+// Keys are hex-encoded hashes that contain no request data.
+type ShortURLStore interface {
+	Get(key string) (StoredShortURL, bool, error)
+	Put(key string, value StoredShortURL) error
+	Delete(key string) error
+}
+
+// DedupOptions contains options for a ShortURLDeduper.
+type DedupOptions struct {
+	// Size is the number of short URLs kept in memory (default: DefaultDedupCacheSize).
+	Size int
+	// Store, if set, persists short URLs beyond the in-memory cache.
+	Store ShortURLStore
+	// ExpiryTolerance is how much earlier than requested a cached short URL
+	// may expire and still be reused (default: DefaultDedupExpiryTolerance).
+	ExpiryTolerance time.Duration
+	// OnStoreError is called when the store fails. Store errors do not fail
+	// CreateShortURL.
+	OnStoreError func(error)
+}
+
+// ShortURLDeduper creates short URLs through a client, returning the
+// existing short URL when the same target URL has already been shortened
+// with the same domain and options. Concurrent identical requests result in
+// a single API call.
+type ShortURLDeduper struct {
+	client *Client
+	opts   DedupOptions
+	memory *lruCache[string, StoredShortURL]
+	group  flightGroup[*CreateShortURLResponse]
+}
+
+// NewShortURLDeduper creates a ShortURLDeduper over client.
+func NewShortURLDeduper(client *Client, opts DedupOptions) *ShortURLDeduper {
+	if opts.Size <= 0 {
+		opts.Size = DefaultDedupCacheSize
+	}
+	if opts.ExpiryTolerance <= 0 {
+		opts.ExpiryTolerance = DefaultDedupExpiryTolerance
+	}
+	return &ShortURLDeduper{client: client, opts: opts, memory: newLRUCache[string, StoredShortURL](opts.Size)}
+}
+
+// CreateShortURL returns a previously created short URL for an equivalent
+// request, or creates one. Requests are equivalent when their normalized
+// target URL, domain and options match. A cached short URL is only reused
+// if it does not expire more than ExpiryTolerance before req.ExpireAt.
+func (d *ShortURLDeduper) CreateShortURL(req CreateShortURLRequest) (*CreateShortURLResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	key, err := dedupKey(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if stored, ok := d.lookup(key, req.ExpireAt); ok {
+		return &stored.Response, nil
+	}
+
+	resp, err := d.group.Do(key, func() (*CreateShortURLResponse, error) {
+		if stored, ok := d.lookup(key, req.ExpireAt); ok {
+			return &stored.Response, nil
+		}
+
+		resp, err := d.client.CreateShortURL(req)
+		if err != nil {
+			return nil, err
+		}
+
+		stored := StoredShortURL{Response: *resp, ExpireAt: req.ExpireAt, CreatedAt: time.Now()}
+		d.memory.Add(key, stored)
+		if d.opts.Store != nil {
+			d.storeError(d.opts.Store.Put(key, stored))
+		}
+		return resp, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Coalesced callers share resp, so each gets its own copy.
+	clone := *resp
+	return &clone, nil
+}
+
+// Forget removes the short URL remembered for an equivalent request, for
+// example after it was deleted.
+func (d *ShortURLDeduper) Forget(req CreateShortURLRequest) error {
+	key, err := dedupKey(req)
+	if err != nil {
+		return err
+	}
+	d.memory.Remove(key)
+	if d.opts.Store != nil {
+		return d.opts.Store.Delete(key)
+	}
+	return nil
+}
+
+// lookup returns a cached short URL that is still usable for a request
+// expiring at expireAt.
+func (d *ShortURLDeduper) lookup(key string, expireAt Expiry) (StoredShortURL, bool) {
+	stored, ok := d.memory.Get(key)
+	if !ok && d.opts.Store != nil {
+		var err error
+		stored, ok, err = d.opts.Store.Get(key)
+		d.storeError(err)
+		if ok {
+			d.memory.Add(key, stored)
+		}
+	}
+	if !ok {
+		return StoredShortURL{}, false
+	}
+
+	if !stored.ExpireAt.IsZero() && !stored.ExpireAt.Time().After(time.Now()) {
+		d.memory.Remove(key)
+		if d.opts.Store != nil {
+			d.storeError(d.opts.Store.Delete(key))
+		}
+		return StoredShortURL{}, false
+	}
+	if !expireAt.IsZero() && stored.ExpireAt.Time().Add(d.opts.ExpiryTolerance).Before(expireAt.Time()) {
+		return StoredShortURL{}, false
+	}
+	return stored, true
+}
+
+func (d *ShortURLDeduper) storeError(err error) {
+	if err != nil && d.opts.OnStoreError != nil {
+		d.opts.OnStoreError(err)
+	}
+}
+
+// dedupKey hashes the normalized target URL, the domain and all options
+// except the expiry, which is checked separately. Whether the link expires
+// at all is part of the key.
+func dedupKey(req CreateShortURLRequest) (string, error) {
+	target, err := normalizeURL(req.TargetURL)
+	if err != nil {
+		return "", err
+	}
+
+	tagIDs := slices.Clone(req.TagIDs)
+	slices.Sort(tagIDs)
+	tagNames := slices.Clone(req.TagNames)
+	slices.Sort(tagNames)
+
+	data, err := json.Marshal([]any{
+		target,
+		strings.ToLower(req.Domain),
+		req.CustomSlug,
+		req.Title,
+		req.Password,
+		req.ExpirationRedirectURL,
+		!req.ExpireAt.IsZero(),
+		tagIDs,
+		tagNames,
+	})
+	if err != nil {
+		return "", fmt.Errorf("marshal dedup key: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// normalizeURL lowercases the scheme and host, removes default ports, and
+// sorts the query parameters.
+func normalizeURL(rawURL string) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("parse target URL: %w", err)
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	host := strings.ToLower(u.Hostname())
+	port := u.Port()
+	if port == "" || (u.Scheme == "http" && port == "80") || (u.Scheme == "https" && port == "443") {
+		u.Host = host
+		if strings.Contains(host, ":") {
+			u.Host = "[" + host + "]"
+		}
+	} else {
+		u.Host = strings.ToLower(u.Host)
+	}
+	if u.Path == "" {
+		u.Path = "/"
+	}
+	if u.RawQuery != "" {
+		u.RawQuery = u.Query().Encode()
+	}
+	return u.String(), nil
+}
+
+// FileShortURLStore is a ShortURLStore backed by a JSON file. The whole
+// file is rewritten on every change, so it suits up to a few thousand
+// entries.
+type FileShortURLStore struct {
+	path string
+
+	mu      sync.Mutex
+	entries map[string]StoredShortURL
+}
+
+// OpenFileShortURLStore opens or creates the store at path.
+func OpenFileShortURLStore(path string) (*FileShortURLStore, error) {
+	s := &FileShortURLStore{path: path, entries: map[string]StoredShortURL{}}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return s, nil
+		}
+		return nil, fmt.Errorf("read short URL store: %w", err)
+	}
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &s.entries); err != nil {
+			return nil, fmt.Errorf("parse short URL store: %w", err)
+		}
+	}
+	return s, nil
+}
+
+// Get returns the short URL stored under key.
+func (s *FileShortURLStore) Get(key string) (StoredShortURL, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	value, ok := s.entries[key]
+	return value, ok, nil
+}
+
+// Put stores value under key and writes the file.
+func (s *FileShortURLStore) Put(key string, value StoredShortURL) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[key] = value
+	return s.saveLocked()
+}
+
+// Delete removes key and writes the file.
+func (s *FileShortURLStore) Delete(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.entries[key]; !ok {
+		return nil
+	}
+	delete(s.entries, key)
+	return s.saveLocked()
+}
+
+func (s *FileShortURLStore) saveLocked() error {
+	data, err := json.Marshal(s.entries)
+	if err != nil {
+		return fmt.Errorf("marshal short URL store: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return fmt.Errorf("create short URL store: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(data)
+	if err == nil {
+		err = tmp.Chmod(0o600)
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("write short URL store: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("replace short URL store: %w", err)
+	}
+	return nil
+}
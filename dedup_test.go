@@ -0,0 +1,140 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: dedup_test.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:44:58
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 04:44:58
+//
+
+package seesdk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNormalizeURL(t *testing.T) {
+	tests := map[string]string{
+		"HTTPS://Example.COM":             "https://example.com/",
+		"https://example.com:443/a?b=2&a": "https://example.com/a?a=&b=2",
+		"http://example.com:8080/":        "http://example.com:8080/",
+		"https://example.com/p#Frag":      "https://example.com/p#Frag",
+	}
+	for in, want := range tests {
+		got, err := normalizeURL(in)
+		if err != nil {
+			t.Fatal("Expected no error, got:", err)
+		}
+		if got != want {
+			t.Errorf("normalizeURL(%q): expected %q, got %q", in, want, got)
+		}
+	}
+}
+
+func TestShortURLDeduper(t *testing.T) {
+	var requests atomic.Int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := requests.Add(1)
+		if n == 1 {
+			<-release
+		}
+		w.Write([]byte(`{"code":200,"data":{"slug":"s` + string(rune('0'+n)) + `"}}`))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "links.json")
+	store, err := OpenFileShortURLStore(path)
+	if err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	d := NewShortURLDeduper(NewClient(Config{BaseURL: server.URL}), DedupOptions{Store: store})
+
+	// Concurrent identical requests are coalesced.
+	var wg sync.WaitGroup
+	slugs := make([]string, 4)
+	for i := range slugs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			resp, err := d.CreateShortURL(CreateShortURLRequest{Domain: "s.ee", TargetURL: "https://example.com/p?b=1&a=2"})
+			if err != nil {
+				t.Error("Expected no error, got:", err)
+				return
+			}
+			slugs[i] = resp.Data.Slug
+		}(i)
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	for _, slug := range slugs {
+		if slug != "s1" {
+			t.Errorf("Expected slug s1, got %q", slug)
+		}
+	}
+
+	// Equivalent URL is served from cache; other options create a new link.
+	resp, _ := d.CreateShortURL(CreateShortURLRequest{Domain: "S.EE", TargetURL: "HTTPS://example.com:443/p?a=2&b=1"})
+	if resp.Data.Slug != "s1" {
+		t.Errorf("Expected cached slug s1, got %q", resp.Data.Slug)
+	}
+	resp, _ = d.CreateShortURL(CreateShortURLRequest{Domain: "s.ee", TargetURL: "https://example.com/p?b=1&a=2", Title: "x"})
+	if resp.Data.Slug != "s2" {
+		t.Errorf("Expected new slug s2, got %q", resp.Data.Slug)
+	}
+	if n := requests.Load(); n != 2 {
+		t.Errorf("Expected 2 requests, got %d", n)
+	}
+
+	// A new deduper over the same store reuses the persisted link.
+	store, _ = OpenFileShortURLStore(path)
+	d = NewShortURLDeduper(NewClient(Config{BaseURL: server.URL}), DedupOptions{Store: store})
+	resp, _ = d.CreateShortURL(CreateShortURLRequest{Domain: "s.ee", TargetURL: "https://example.com/p?a=2&b=1"})
+	if resp.Data.Slug != "s1" || requests.Load() != 2 {
+		t.Errorf("Expected persisted slug s1, got %q", resp.Data.Slug)
+	}
+}
+
+func TestShortURLDeduperExpiry(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Write([]byte(`{"code":200,"data":{"slug":"abc"}}`))
+	}))
+	defer server.Close()
+
+	d := NewShortURLDeduper(NewClient(Config{BaseURL: server.URL}), DedupOptions{ExpiryTolerance: time.Minute})
+	req := CreateShortURLRequest{Domain: "s.ee", TargetURL: "https://example.com", ExpireAt: ExpiresIn(time.Hour)}
+	d.CreateShortURL(req)
+	d.CreateShortURL(req)
+	if n := requests.Load(); n != 1 {
+		t.Errorf("Expected 1 request, got %d", n)
+	}
+
+	// The cached link expires too early for a longer-lived request.
+	req.ExpireAt = ExpiresIn(2 * time.Hour)
+	d.CreateShortURL(req)
+	if n := requests.Load(); n != 2 {
+		t.Errorf("Expected 2 requests, got %d", n)
+	}
+
+	// Links that expire are not reused for one that must not expire.
+	req.ExpireAt = 0
+	d.CreateShortURL(req)
+	if n := requests.Load(); n != 3 {
+		t.Errorf("Expected 3 requests, got %d", n)
+	}
+}
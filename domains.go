@@ -0,0 +1,209 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: domains.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:34:06
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 05:12:50
+//
+
+package seesdk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DomainKind identifies the resource type a domain list applies to.
+type DomainKind string
+
+const (
+	DomainKindShortURL DomainKind = "short_url"
+	DomainKindText     DomainKind = "text"
+	DomainKindFile     DomainKind = "file"
+)
+
+// DefaultDomainCacheTTL is the default lifetime of cached domain lists.
+const DefaultDomainCacheTTL = 10 * time.Minute
+
+// DomainCatalog caches the domain lists for short URLs, texts and files.
+type DomainCatalog struct {
+	client *Client
+	ttl    time.Duration
+
+	mu      sync.Mutex
+	entries map[DomainKind]domainEntry
+}
+
+type domainEntry struct {
+	domains []string
+	fetched time.Time
+}
+
+// NewDomainCatalog creates a DomainCatalog whose lists expire after ttl
+// (default: DefaultDomainCacheTTL).
+func NewDomainCatalog(client *Client, ttl time.Duration) *DomainCatalog {
+	if ttl <= 0 {
+		ttl = DefaultDomainCacheTTL
+	}
+	return &DomainCatalog{client: client, ttl: ttl}
+}
+
+// Domains returns a copy of the available domains for kind, fetching them
+// if the cached list is missing or expired. If a refetch fails, the stale
+// list is returned when there is one.
+func (d *DomainCatalog) Domains(kind DomainKind) ([]string, error) {
+	d.mu.Lock()
+	entry, ok := d.entries[kind]
+	d.mu.Unlock()
+	if ok && time.Since(entry.fetched) < d.ttl {
+		return append([]string(nil), entry.domains...), nil
+	}
+
+	domains, err := d.refresh(kind)
+	if err != nil {
+		if ok {
+			return append([]string(nil), entry.domains...), nil
+		}
+		return nil, err
+	}
+	return append([]string(nil), domains...), nil
+}
+
+// DefaultFor returns the default domain for kind, which is the first domain
+// in the list served by the API.
+func (d *DomainCatalog) DefaultFor(kind DomainKind) (string, error) {
+	domains, err := d.Domains(kind)
+	if err != nil {
+		return "", err
+	}
+	if len(domains) == 0 {
+		return "", fmt.Errorf("no %s domains available", kind)
+	}
+	return domains[0], nil
+}
+
+// Supports reports whether domain is available for kind. Domains are
+// compared case-insensitively.
+func (d *DomainCatalog) Supports(kind DomainKind, domain string) (bool, error) {
+	domains, err := d.Domains(kind)
+	if err != nil {
+		return false, err
+	}
+	for _, available := range domains {
+		if strings.EqualFold(available, domain) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Refresh refetches the domain lists of all kinds.
+func (d *DomainCatalog) Refresh() error {
+	for _, kind := range []DomainKind{DomainKindShortURL, DomainKindText, DomainKindFile} {
+		if _, err := d.refresh(kind); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StartRefresh refreshes the domain lists every interval in the background
+// until ctx is done, so lookups do not wait for the network. A non-positive
+// interval uses half the cache TTL. Failed refreshes keep the previous lists.
+func (d *DomainCatalog) StartRefresh(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = d.ttl / 2
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				d.Refresh()
+			}
+		}
+	}()
+}
+
+// Invalidate clears all cached lists.
+func (d *DomainCatalog) Invalidate() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.entries = nil
+}
+
+func (d *DomainCatalog) refresh(kind DomainKind) ([]string, error) {
+	var (
+		response *DomainsResponse
+		err      error
+	)
+	switch kind {
+	case DomainKindShortURL:
+		response, err = d.client.GetDomains()
+	case DomainKindText:
+		response, err = d.client.GetTextDomains()
+	case DomainKindFile:
+		response, err = d.client.GetFileDomains()
+	default:
+		return nil, fmt.Errorf("unknown domain kind %q", kind)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get %s domains: %w", kind, err)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.entries == nil {
+		d.entries = map[DomainKind]domainEntry{}
+	}
+	d.entries[kind] = domainEntry{domains: response.Data.Domains, fetched: time.Now()}
+	return response.Data.Domains, nil
+}
+
+// DomainCatalog returns the client's shared DomainCatalog, which is used to
+// fill in default domains and to verify domains when VerifyDomains is set.
+func (c *Client) DomainCatalog() *DomainCatalog {
+	c.domainsOnce.Do(func() {
+		c.domains = NewDomainCatalog(c, DefaultDomainCacheTTL)
+	})
+	return c.domains
+}
+
+// prepareDomain fills in the default domain for kind when domain is empty
+// and verifies it when VerifyDomains is set. An empty text domain is left
+// for the server to fill in unless VerifyDomains is set.
+func (c *Client) prepareDomain(kind DomainKind, domain string) (string, error) {
+	if domain == "" {
+		if kind == DomainKindText && !c.VerifyDomains {
+			return "", nil
+		}
+		return c.DomainCatalog().DefaultFor(kind)
+	}
+	if !c.VerifyDomains {
+		return domain, nil
+	}
+
+	ok, err := c.DomainCatalog().Supports(kind, domain)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		domains, _ := c.DomainCatalog().Domains(kind)
+		var v validator
+		v.add("domain", "%q is not available, expected one of: %s", domain, strings.Join(domains, ", "))
+		return "", v.err()
+	}
+	return domain, nil
+}
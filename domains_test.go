@@ -0,0 +1,126 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: domains_test.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:34:15
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 05:12:50
+//
+
+package seesdk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDomainCatalog(t *testing.T) {
+	requests := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		switch r.URL.Path {
+		case "/domains":
+			w.Write([]byte(`{"code":200,"data":{"domains":["s.ee","example.link"]}}`))
+		case "/text/domains":
+			w.Write([]byte(`{"code":200,"data":{"domains":["paste.s.ee"]}}`))
+		case "/file/domains":
+			w.Write([]byte(`{"code":200,"data":{"domains":[]}}`))
+		}
+	}))
+	defer server.Close()
+
+	catalog := NewDomainCatalog(NewClient(Config{BaseURL: server.URL}), 0)
+
+	domain, err := catalog.DefaultFor(DomainKindShortURL)
+	if err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	if domain != "s.ee" {
+		t.Errorf("Expected default s.ee, got %s", domain)
+	}
+
+	ok, err := catalog.Supports(DomainKindShortURL, "Example.Link")
+	if err != nil || !ok {
+		t.Errorf("Expected Example.Link to be supported, got %v, %v", ok, err)
+	}
+	if ok, _ := catalog.Supports(DomainKindText, "s.ee"); ok {
+		t.Error("Expected s.ee not to be a text domain")
+	}
+	if _, err := catalog.DefaultFor(DomainKindFile); err == nil {
+		t.Error("Expected error for empty file domain list")
+	}
+
+	if requests["/domains"] != 1 || requests["/text/domains"] != 1 {
+		t.Errorf("Expected each list to be fetched once, got %v", requests)
+	}
+
+	domains, _ := catalog.Domains(DomainKindShortURL)
+	domains[0] = "changed"
+	if domain, _ := catalog.DefaultFor(DomainKindShortURL); domain != "s.ee" {
+		t.Errorf("Expected cached list to be unaffected by callers, got %s", domain)
+	}
+
+	catalog.Invalidate()
+	catalog.Domains(DomainKindShortURL)
+	if requests["/domains"] != 2 {
+		t.Errorf("Expected refetch after Invalidate, got %d requests", requests["/domains"])
+	}
+}
+
+func TestCreateShortURLDefaultDomain(t *testing.T) {
+	var sent CreateShortURLRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/domains":
+			w.Write([]byte(`{"code":200,"data":{"domains":["s.ee"]}}`))
+		case "/shorten":
+			json.NewDecoder(r.Body).Decode(&sent)
+			w.Write([]byte(`{"code":200,"data":{"slug":"abc"}}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{BaseURL: server.URL})
+	if _, err := client.CreateShortURL(CreateShortURLRequest{TargetURL: "https://example.com"}); err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	if sent.Domain != "s.ee" {
+		t.Errorf("Expected domain s.ee, got %q", sent.Domain)
+	}
+}
+
+func TestCreateTextDefaultDomain(t *testing.T) {
+	var sent map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/text/domains":
+			w.WriteHeader(http.StatusInternalServerError)
+		case "/text":
+			sent = nil
+			json.NewDecoder(r.Body).Decode(&sent)
+			w.Write([]byte(`{"code":200,"data":{"slug":"abc"}}`))
+		}
+	}))
+	defer server.Close()
+
+	// The server picks the text domain, so the domain list is not needed.
+	client := NewClient(Config{BaseURL: server.URL})
+	if _, err := client.CreateText(CreateTextRequest{Content: "hello"}); err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	if _, ok := sent["domain"]; ok {
+		t.Errorf("Expected domain to be omitted, got %v", sent["domain"])
+	}
+
+	client = NewClient(Config{BaseURL: server.URL, VerifyDomains: true})
+	if _, err := client.CreateText(CreateTextRequest{Content: "hello"}); err == nil {
+		t.Error("Expected error when the text domains cannot be verified")
+	}
+}
@@ -0,0 +1,221 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: download.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:22:13
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 05:13:14
+//
+
+package seesdk
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// DownloadOptions contains options for downloading a file.
+type DownloadOptions struct {
+	// Offset resumes a download from the given byte offset using a range
+	// request. Only the remaining bytes are written to w.
+	Offset int64
+	// Prefix supplies the first Offset bytes already downloaded so that a
+	// resumed download can still be verified against ExpectedSHA256.
+	Prefix io.Reader
+	// ExpectedSize is the total size of the file in bytes. Zero skips the check.
+	ExpectedSize int64
+	// ExpectedSHA256 is the hex-encoded SHA-256 digest of the whole file.
+	// Empty skips the check.
+	ExpectedSHA256 string
+}
+
+// DownloadResult describes a completed download.
+type DownloadResult struct {
+	// Written is the number of bytes written to w.
+	Written int64
+	// Size is the total size of the file, including any resumed prefix.
+	Size int64
+	// SHA256 is the hex-encoded digest of the whole file. It is empty when
+	// a resumed download had no Prefix to hash.
+	SHA256      string
+	ContentType string
+}
+
+// DownloadOptionsFor returns options that verify a download against the
+// metadata returned when the file was uploaded. The size is always checked.
+// The Hash field is only used as ExpectedSHA256 when it is a hex-encoded
+// SHA-256 digest; the API may return other identifiers there, which cannot
+// be verified locally.
+func DownloadOptionsFor(resp *UploadFileResponse) DownloadOptions {
+	opts := DownloadOptions{ExpectedSize: int64(resp.Data.Size)}
+	if isSHA256Hex(resp.Data.Hash) {
+		opts.ExpectedSHA256 = strings.ToLower(resp.Data.Hash)
+	}
+	return opts
+}
+
+// isSHA256Hex reports whether s is a hex-encoded SHA-256 digest.
+func isSHA256Hex(s string) bool {
+	if len(s) != hex.EncodedLen(sha256.Size) {
+		return false
+	}
+	_, err := hex.DecodeString(s)
+	return err == nil
+}
+
+// DownloadFile downloads the content at fileURL, typically the URL of an
+// UploadFileResponse, and writes it to w.
+func (c *Client) DownloadFile(ctx context.Context, fileURL string, w io.Writer) (*DownloadResult, error) {
+	return c.DownloadFileWithOptions(ctx, fileURL, w, DownloadOptions{})
+}
+
+// DownloadFileWithOptions downloads the content at fileURL to w, resuming
+// and verifying it according to opts. The API key is never sent along, as
+// file URLs are served from public domains. The client timeout does not
+// apply to the download, which is bounded by ctx instead.
+func (c *Client) DownloadFileWithOptions(ctx context.Context, fileURL string, w io.Writer, opts DownloadOptions) (*DownloadResult, error) {
+	if opts.Offset < 0 {
+		return nil, fmt.Errorf("invalid offset %d", opts.Offset)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", fileURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+	if opts.Offset > 0 {
+		req.Header.Set("Range", "bytes="+strconv.FormatInt(opts.Offset, 10)+"-")
+	}
+
+	resp, err := c.streamingClient().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusPartialContent {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return nil, &APIError{StatusCode: resp.StatusCode, Body: string(body)}
+	}
+
+	var h hash.Hash
+	if opts.Offset == 0 || opts.Prefix != nil || resp.StatusCode == http.StatusOK {
+		h = sha256.New()
+	}
+
+	body := io.Reader(resp.Body)
+	if opts.Offset > 0 {
+		if resp.StatusCode == http.StatusPartialContent {
+			start, err := contentRangeStart(resp.Header.Get("Content-Range"))
+			if err != nil {
+				return nil, err
+			}
+			if start != opts.Offset {
+				return nil, fmt.Errorf("server returned range starting at %d, requested %d", start, opts.Offset)
+			}
+			if h != nil {
+				if _, err := io.CopyN(h, opts.Prefix, opts.Offset); err != nil {
+					return nil, fmt.Errorf("hash prefix: %w", err)
+				}
+			}
+		} else {
+			// The server ignored the range, skip what we already have.
+			skip := io.Discard
+			if h != nil {
+				skip = h
+			}
+			if _, err := io.CopyN(skip, resp.Body, opts.Offset); err != nil {
+				return nil, fmt.Errorf("skip downloaded prefix: %w", err)
+			}
+		}
+	}
+	if h != nil {
+		body = io.TeeReader(body, h)
+	}
+
+	written, err := io.Copy(w, body)
+	if err != nil {
+		return nil, fmt.Errorf("download: %w", err)
+	}
+
+	result := &DownloadResult{
+		Written:     written,
+		Size:        opts.Offset + written,
+		ContentType: resp.Header.Get("Content-Type"),
+	}
+	if h != nil {
+		result.SHA256 = hex.EncodeToString(h.Sum(nil))
+	}
+
+	if opts.ExpectedSize > 0 && result.Size != opts.ExpectedSize {
+		return nil, fmt.Errorf("size mismatch: expected %d bytes, got %d", opts.ExpectedSize, result.Size)
+	}
+	if opts.ExpectedSHA256 != "" {
+		if result.SHA256 == "" {
+			return nil, fmt.Errorf("cannot verify SHA-256 of resumed download without prefix")
+		}
+		if !strings.EqualFold(result.SHA256, opts.ExpectedSHA256) {
+			return nil, fmt.Errorf("SHA-256 mismatch: expected %s, got %s", opts.ExpectedSHA256, result.SHA256)
+		}
+	}
+
+	return result, nil
+}
+
+// contentRangeStart parses the first byte position of a Content-Range header.
+func contentRangeStart(header string) (int64, error) {
+	spec, ok := strings.CutPrefix(header, "bytes ")
+	if !ok {
+		return 0, fmt.Errorf("invalid Content-Range %q", header)
+	}
+	start, _, ok := strings.Cut(spec, "-")
+	if !ok {
+		return 0, fmt.Errorf("invalid Content-Range %q", header)
+	}
+	n, err := strconv.ParseInt(start, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid Content-Range %q", header)
+	}
+	return n, nil
+}
+
+// GetTextContent retrieves the content of a text.
+func (c *Client) GetTextContent(ctx context.Context, domain, slug string) (*TextContentResponse, error) {
+	return c.getTextContent(ctx, domain, slug, "")
+}
+
+// GetProtectedTextContent retrieves the content of a password-protected text.
+func (c *Client) GetProtectedTextContent(ctx context.Context, domain, slug, password string) (*TextContentResponse, error) {
+	return c.getTextContent(ctx, domain, slug, password)
+}
+
+func (c *Client) getTextContent(ctx context.Context, domain, slug, password string) (*TextContentResponse, error) {
+	var header http.Header
+	if password != "" {
+		header = http.Header{}
+		header.Set("X-Text-Password", password)
+	}
+
+	respBody, err := c.doRequestContext(ctx, "GET", "/text/content?"+slugQuery(domain, slug), nil, header)
+	if err != nil {
+		return nil, err
+	}
+
+	var response TextContentResponse
+	if err := unmarshalResponse(respBody, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
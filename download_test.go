@@ -0,0 +1,163 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: download_test.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:22:22
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 05:13:14
+//
+
+package seesdk
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDownloadFile(t *testing.T) {
+	content := []byte(strings.Repeat("0123456789", 100))
+	sum := sha256.Sum256(content)
+	digest := hex.EncodeToString(sum[:])
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "" {
+			t.Error("Expected no Authorization header on download")
+		}
+		http.ServeContent(w, r, "file.txt", time.Time{}, bytes.NewReader(content))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{APIKey: "secret"})
+
+	var buf bytes.Buffer
+	result, err := client.DownloadFileWithOptions(context.Background(), server.URL, &buf, DownloadOptions{
+		ExpectedSize:   int64(len(content)),
+		ExpectedSHA256: digest,
+	})
+	if err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	if !bytes.Equal(buf.Bytes(), content) || result.SHA256 != digest {
+		t.Error("Expected downloaded content to match")
+	}
+
+	// Resume from the middle, verifying the whole file with the prefix.
+	buf.Reset()
+	result, err = client.DownloadFileWithOptions(context.Background(), server.URL, &buf, DownloadOptions{
+		Offset:         400,
+		Prefix:         bytes.NewReader(content[:400]),
+		ExpectedSize:   int64(len(content)),
+		ExpectedSHA256: digest,
+	})
+	if err != nil {
+		t.Fatal("Expected no error on resume, got:", err)
+	}
+	if !bytes.Equal(buf.Bytes(), content[400:]) || result.Written != 600 {
+		t.Errorf("Expected 600 resumed bytes, got %d", result.Written)
+	}
+
+	if _, err := client.DownloadFileWithOptions(context.Background(), server.URL, &buf, DownloadOptions{
+		ExpectedSize: 1,
+	}); err == nil {
+		t.Error("Expected size mismatch error")
+	}
+}
+
+func TestDownloadFileIgnoresClientTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for i := 0; i < 4; i++ {
+			w.Write([]byte("chunk"))
+			w.(http.Flusher).Flush()
+			time.Sleep(50 * time.Millisecond)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Timeout: 100 * time.Millisecond})
+	var out bytes.Buffer
+	if _, err := client.DownloadFile(context.Background(), server.URL, &out); err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	if out.String() != strings.Repeat("chunk", 4) {
+		t.Errorf("Expected full content, got %q", out.String())
+	}
+}
+
+func TestDownloadOptionsFor(t *testing.T) {
+	var resp UploadFileResponse
+	resp.Data.Size = 1000
+	resp.Data.Hash = "aBcD1234"
+
+	opts := DownloadOptionsFor(&resp)
+	if opts.ExpectedSize != 1000 || opts.ExpectedSHA256 != "" {
+		t.Errorf("Expected size only for non-digest hash, got %+v", opts)
+	}
+
+	sum := sha256.Sum256([]byte("content"))
+	resp.Data.Hash = strings.ToUpper(hex.EncodeToString(sum[:]))
+	opts = DownloadOptionsFor(&resp)
+	if opts.ExpectedSHA256 != hex.EncodeToString(sum[:]) {
+		t.Errorf("Expected SHA-256 from hash, got %q", opts.ExpectedSHA256)
+	}
+}
+
+func TestGetTextContent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/text/content" {
+			t.Errorf("Expected path /text/content, got %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("domain") != "s.ee" || r.URL.Query().Get("slug") != "a b" {
+			t.Errorf("Unexpected query %s", r.URL.RawQuery)
+		}
+		switch r.Header.Get("X-Text-Password") {
+		case "":
+			if r.Header.Get("Authorization") != "key" {
+				t.Error("Expected Authorization header")
+			}
+			w.Write([]byte(`{"code":200,"data":{"content":"hello","text_type":"plain_text","title":"Greeting"}}`))
+		case "secret":
+			w.Write([]byte(`{"code":200,"data":{"content":"protected"}}`))
+		default:
+			w.WriteHeader(http.StatusForbidden)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{BaseURL: server.URL, APIKey: "key"})
+	ctx := context.Background()
+
+	resp, err := client.GetTextContent(ctx, "s.ee", "a b")
+	if err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	if resp.Data.Content != "hello" || resp.Data.Title != "Greeting" {
+		t.Errorf("Unexpected content %+v", resp.Data)
+	}
+
+	resp, err = client.GetProtectedTextContent(ctx, "s.ee", "a b", "secret")
+	if err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	if resp.Data.Content != "protected" {
+		t.Errorf("Expected protected content, got %q", resp.Data.Content)
+	}
+
+	_, err = client.GetProtectedTextContent(ctx, "s.ee", "a b", "wrong")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusForbidden {
+		t.Errorf("Expected 403 APIError, got: %v", err)
+	}
+}
@@ -9,7 +9,7 @@
 // File Created: 2025-11-28 11:26:23
 //
 // Modified By: S.EE Development Team <dev@s.ee>
-// Last Modified: 2025-12-04 17:58:16
+// Last Modified: 2026-10-19 04:31:19
 //
 
 package main
@@ -129,13 +129,11 @@ func createCustomShortURL() {
 	fmt.Println("=== Create Custom Short URL ===")
 	// Use a unique slug to avoid conflicts in repeated runs
 	customSlug := fmt.Sprintf("custom-%d", time.Now().Unix())
-	expireAt := time.Now().Add(30 * 24 * time.Hour).Unix()
-
 	customResp, err := client.CreateShortURL(seesdk.CreateShortURLRequest{
 		TargetURL:  "https://www.example.com/custom",
 		Domain:     defaultDomain,
 		CustomSlug: customSlug,
-		ExpireAt:   expireAt,
+		ExpireAt:   seesdk.ExpiresIn(30 * 24 * time.Hour),
 		Title:      "Custom Link",
 		// TagIDs:     []int64{1, 2}, // Optional
 	})
@@ -168,7 +166,7 @@ func updateShortURL(slug string) {
 		Domain:    defaultDomain,
 		Slug:      slug,
 		TargetURL: "https://www.example.com/updated",
-		Title:     "Updated Link",
+		Title:     seesdk.Set("Updated Link"),
 	})
 	if err != nil {
 		log.Printf("Failed to update short URL: %v\n", err)
@@ -0,0 +1,101 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: get_test.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:59:32
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 04:59:32
+//
+
+package seesdk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSlugQuery(t *testing.T) {
+	if got := slugQuery("s.ee", "a b&c"); got != "domain=s.ee&slug=a+b%26c" {
+		t.Errorf("Expected escaped query, got %s", got)
+	}
+}
+
+func TestGetShortURLUpdateRequest(t *testing.T) {
+	var sent map[string]any
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/shorten" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		switch r.Method {
+		case "GET":
+			if r.URL.RawQuery != "domain=s.ee&slug=abc" {
+				t.Errorf("Expected slug query, got %s", r.URL.RawQuery)
+			}
+			// The link has already expired.
+			w.Write([]byte(`{"code":200,"data":{"domain":"s.ee","slug":"abc","target_url":"https://example.com",` +
+				`"title":"Example","expire_at":1600000000,"tags":[{"id":4,"name":"go"}]}}`))
+		case "PUT":
+			json.NewDecoder(r.Body).Decode(&sent)
+			w.Write([]byte(`{"code":200,"data":{}}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{BaseURL: server.URL})
+
+	resp, err := client.GetShortURL("s.ee", "abc")
+	if err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	if resp.Data.ExpireAt != 1600000000 || len(resp.Data.Tags) != 1 {
+		t.Errorf("Expected link details to be decoded, got %+v", resp.Data)
+	}
+
+	req := resp.Data.UpdateRequest()
+	req.TargetURL = "https://example.org"
+	if _, err := client.UpdateShortURL(req); err != nil {
+		t.Fatal("Expected updating an expired link to succeed, got:", err)
+	}
+	if _, ok := sent["expire_at"]; ok {
+		t.Errorf("Expected unchanged expiry to be omitted, got %v", sent["expire_at"])
+	}
+	if sent["target_url"] != "https://example.org" || sent["title"] != "Example" {
+		t.Errorf("Expected target URL and title to be sent, got %v", sent)
+	}
+
+	// A link without expiry keeps it cleared.
+	if req := (&ShortURL{Domain: "s.ee", Slug: "abc"}).UpdateRequest(); !req.ExpireAt.IsClear() {
+		t.Error("Expected expiry to be cleared for a link that never expires")
+	}
+}
+
+func TestGetText(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/text" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if r.URL.RawQuery != "domain=s.ee&slug=note" {
+			t.Errorf("Expected slug query, got %s", r.URL.RawQuery)
+		}
+		w.Write([]byte(`{"code":200,"data":{"slug":"note","text_type":"markdown","has_password":true,"size":42}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{BaseURL: server.URL})
+
+	resp, err := client.GetText("s.ee", "note")
+	if err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	if resp.Data.TextType != "markdown" || !resp.Data.HasPassword || resp.Data.Size != 42 {
+		t.Errorf("Expected text details to be decoded, got %+v", resp.Data)
+	}
+}
@@ -0,0 +1,125 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: idempotency.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:43:20
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 05:15:24
+//
+
+package seesdk
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// DefaultIdempotencyCacheSize is the default number of idempotency keys
+// whose responses are remembered by a client.
+const DefaultIdempotencyCacheSize = 1000
+
+// ErrIdempotencyKeyReused is returned when an idempotency key is reused
+// with a different request.
+var ErrIdempotencyKeyReused = errors.New("idempotency key reused with a different request")
+
+// errCallAborted is returned to callers waiting on a call that panicked.
+var errCallAborted = errors.New("idempotent call aborted")
+
+// idempotentCall is a request made with an idempotency key. done is closed
+// once response and err are set.
+type idempotentCall struct {
+	fingerprint [sha256.Size]byte
+	done        chan struct{}
+	response    any
+	err         error
+}
+
+// idempotent runs call at most once per key while the key is cached. Later
+// calls with the same key and request wait for the first one and get a
+// shallow copy of its response. Failed calls are forgotten so that they can
+// be retried.
+func idempotent[T any](c *Client, key, endpoint string, req any, tagNames []string, call func() (*T, error)) (*T, error) {
+	data, err := json.Marshal(struct {
+		Endpoint string   `json:"endpoint"`
+		Request  any      `json:"request"`
+		TagNames []string `json:"tag_names"`
+	}{endpoint, req, tagNames})
+	if err != nil {
+		return nil, fmt.Errorf("marshal request body: %w", err)
+	}
+
+	cacheKey := endpoint + "\x00" + key
+	ic := &idempotentCall{fingerprint: sha256.Sum256(data), done: make(chan struct{})}
+	existing, found := c.idempotencyCache().GetOrAdd(cacheKey, ic)
+	if found {
+		if existing.fingerprint != ic.fingerprint {
+			return nil, ErrIdempotencyKeyReused
+		}
+		<-existing.done
+		if existing.err != nil {
+			return nil, existing.err
+		}
+		response := *existing.response.(*T)
+		return &response, nil
+	}
+
+	// Waiters are released even if call panics, and see errCallAborted.
+	ic.err = errCallAborted
+	defer func() {
+		if ic.err != nil {
+			// The entry may have been evicted and replaced by another call.
+			c.idempotencyCache().RemoveFunc(cacheKey, func(v *idempotentCall) bool { return v == ic })
+		}
+		close(ic.done)
+	}()
+
+	response, err := call()
+	if err != nil {
+		ic.err = err
+		return nil, err
+	}
+	stored := *response
+	ic.response, ic.err = &stored, nil
+	return response, nil
+}
+
+// withoutExpiry returns req without ExpireAt for fingerprinting, since a
+// retry that rebuilds the request with ExpiresIn gets a later expiry.
+func (req CreateShortURLRequest) withoutExpiry() CreateShortURLRequest {
+	req.ExpireAt = 0
+	return req
+}
+
+// withoutExpiry returns req without ExpireAt for fingerprinting.
+func (req CreateTextRequest) withoutExpiry() CreateTextRequest {
+	req.ExpireAt = 0
+	return req
+}
+
+// idempotencyHeader returns the header carrying key, or nil if key is empty.
+func idempotencyHeader(key string) http.Header {
+	if key == "" {
+		return nil
+	}
+	return http.Header{"Idempotency-Key": {key}}
+}
+
+// idempotencyCache returns the client's cache of idempotent calls.
+func (c *Client) idempotencyCache() *lruCache[string, *idempotentCall] {
+	c.idempotencyOnce.Do(func() {
+		size := c.IdempotencyCacheSize
+		if size <= 0 {
+			size = DefaultIdempotencyCacheSize
+		}
+		c.idempotency = newLRUCache[string, *idempotentCall](size)
+	})
+	return c.idempotency
+}
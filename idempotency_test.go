@@ -0,0 +1,196 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: idempotency_test.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:43:40
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 05:15:24
+//
+
+package seesdk
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCreateShortURLIdempotencyKey(t *testing.T) {
+	var mu sync.Mutex
+	requests := 0
+	fail := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		requests++
+		if got := r.Header.Get("Idempotency-Key"); got != "order-42" {
+			t.Errorf("Expected Idempotency-Key order-42, got %q", got)
+		}
+		if fail {
+			fail = false
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte(`{"code":200,"data":{"slug":"abc"}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{BaseURL: server.URL})
+	req := CreateShortURLRequest{Domain: "s.ee", TargetURL: "https://example.com", IdempotencyKey: "order-42"}
+
+	// Failed attempts are not cached.
+	if _, err := client.CreateShortURL(req); err == nil {
+		t.Fatal("Expected first attempt to fail")
+	}
+
+	var wg sync.WaitGroup
+	slugs := make([]string, 5)
+	for i := range slugs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			resp, err := client.CreateShortURL(req)
+			if err != nil {
+				t.Error("Expected no error, got:", err)
+				return
+			}
+			slugs[i] = resp.Data.Slug
+		}(i)
+	}
+	wg.Wait()
+
+	if requests != 2 {
+		t.Errorf("Expected 2 requests, got %d", requests)
+	}
+	for _, slug := range slugs {
+		if slug != "abc" {
+			t.Errorf("Expected slug abc, got %q", slug)
+		}
+	}
+
+	// A retry that rebuilds a relative expiry is the same request.
+	req.ExpireAt = ExpiresIn(time.Hour)
+	if _, err := client.CreateShortURL(req); err != nil {
+		t.Error("Expected no error for a different expiry, got:", err)
+	}
+
+	req.TargetURL = "https://example.org"
+	if _, err := client.CreateShortURL(req); !errors.Is(err, ErrIdempotencyKeyReused) {
+		t.Errorf("Expected ErrIdempotencyKeyReused, got: %v", err)
+	}
+}
+
+func TestCreateTextWithoutIdempotencyKey(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if _, ok := r.Header["Idempotency-Key"]; ok {
+			t.Error("Expected no Idempotency-Key header")
+		}
+		w.Write([]byte(`{"code":200,"data":{"slug":"abc"}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{BaseURL: server.URL})
+	req := CreateTextRequest{Domain: "s.ee", Content: "hello"}
+	client.CreateText(req)
+	client.CreateText(req)
+	if requests != 2 {
+		t.Errorf("Expected 2 requests, got %d", requests)
+	}
+}
+
+func TestIdempotentSharedResponse(t *testing.T) {
+	client := NewClient(Config{})
+	call := func() (*CreateShortURLResponse, error) {
+		return &CreateShortURLResponse{Data: ShortURLData{Slug: "abc"}}, nil
+	}
+
+	first, _ := idempotent(client, "k", "/shorten", 1, nil, call)
+	first.Data.Slug = "changed"
+	second, _ := idempotent(client, "k", "/shorten", 1, nil, call)
+	second.Data.Slug = "changed too"
+	third, _ := idempotent(client, "k", "/shorten", 1, nil, call)
+	if third.Data.Slug != "abc" {
+		t.Errorf("Expected callers not to share the response, got slug %q", third.Data.Slug)
+	}
+}
+
+func TestIdempotentPanic(t *testing.T) {
+	client := NewClient(Config{})
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	go func() {
+		defer func() { recover() }()
+		idempotent(client, "k", "/shorten", 1, nil, func() (*CreateShortURLResponse, error) {
+			close(started)
+			<-release
+			panic("boom")
+		})
+	}()
+	<-started
+
+	waiter := make(chan error, 1)
+	go func() {
+		_, err := idempotent(client, "k", "/shorten", 1, nil, func() (*CreateShortURLResponse, error) {
+			return &CreateShortURLResponse{}, nil
+		})
+		waiter <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+
+	select {
+	case err := <-waiter:
+		if !errors.Is(err, errCallAborted) {
+			t.Errorf("Expected errCallAborted, got: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected waiter to be released after a panic")
+	}
+	if _, ok := client.idempotencyCache().Get("/shorten\x00k"); ok {
+		t.Error("Expected the aborted call to be forgotten")
+	}
+}
+
+func TestIdempotentFailureAfterEviction(t *testing.T) {
+	client := NewClient(Config{IdempotencyCacheSize: 1})
+	release := make(chan struct{})
+	started := make(chan struct{})
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		idempotent(client, "a", "/shorten", 1, nil, func() (*CreateShortURLResponse, error) {
+			close(started)
+			<-release
+			return nil, errors.New("failed")
+		})
+	}()
+	<-started
+
+	calls := 0
+	succeed := func() (*CreateShortURLResponse, error) {
+		calls++
+		return &CreateShortURLResponse{}, nil
+	}
+	idempotent(client, "b", "/shorten", 1, nil, succeed) // evicts the first "a"
+	idempotent(client, "a", "/shorten", 1, nil, succeed)
+	close(release)
+	<-done
+
+	// The failed call must not remove the newer entry for "a".
+	idempotent(client, "a", "/shorten", 1, nil, succeed)
+	if calls != 2 {
+		t.Errorf("Expected the newer call for a to stay cached, got %d calls", calls)
+	}
+}
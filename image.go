@@ -0,0 +1,389 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: image.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:21:15
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 05:17:12
+//
+
+package seesdk
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+)
+
+// DefaultJPEGQuality is the quality used when re-encoding JPEG images.
+const DefaultJPEGQuality = 90
+
+// maxImagePixels guards against decompression bombs.
+const maxImagePixels = 100 * 1000 * 1000
+
+// ImageOptions contains options for the image upload pipeline.
+type ImageOptions struct {
+	// MaxWidth and MaxHeight bound the output size. Images are only ever
+	// scaled down, preserving the aspect ratio. Zero means no limit.
+	MaxWidth  int
+	MaxHeight int
+	// JPEGQuality is the re-encoding quality for JPEG images (default: DefaultJPEGQuality).
+	JPEGQuality int
+}
+
+// ImageInfo describes the result of processing an image.
+type ImageInfo struct {
+	Format         string // "jpeg", "png" or "gif"
+	OriginalWidth  int    // After applying the EXIF orientation
+	OriginalHeight int
+	Width          int
+	Height         int
+	Resized        bool
+}
+
+// ProcessedImage is an image re-encoded without metadata.
+type ProcessedImage struct {
+	ImageInfo
+	Data []byte
+}
+
+// UploadImageResponse represents the response from uploading a processed image.
+type UploadImageResponse struct {
+	*UploadFileResponse
+	Image ImageInfo
+}
+
+// UploadImage decodes a JPEG, PNG or GIF image, strips all metadata such as
+// EXIF GPS tags by re-encoding it, optionally downscales it and uploads the
+// result.
+func (c *Client) UploadImage(filename string, r io.Reader, opts ImageOptions) (*UploadImageResponse, error) {
+	processed, err := ProcessImage(r, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.UploadFile(filename, bytes.NewReader(processed.Data))
+	if err != nil {
+		return nil, err
+	}
+
+	return &UploadImageResponse{
+		UploadFileResponse: response,
+		Image:              processed.ImageInfo,
+	}, nil
+}
+
+// ProcessImage decodes an image, applies the JPEG EXIF orientation, resizes it
+// according to opts and re-encodes it in its original format. The output
+// carries no metadata.
+func ProcessImage(r io.Reader, opts ImageOptions) (*ProcessedImage, error) {
+	if r == nil {
+		return nil, fmt.Errorf("image is nil")
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r, maxUploadSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("read image: %w", err)
+	}
+	if len(data) > maxUploadSize {
+		return nil, fmt.Errorf("file size exceeds the limit of %d bytes", maxUploadSize)
+	}
+
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("decode image config: %w", err)
+	}
+	if config.Width*config.Height > maxImagePixels {
+		return nil, fmt.Errorf("image dimensions %dx%d are too large", config.Width, config.Height)
+	}
+
+	switch format {
+	case "jpeg":
+		return processJPEG(data, opts)
+	case "png":
+		return processPNG(data, opts)
+	case "gif":
+		return processGIF(data, opts)
+	default:
+		return nil, fmt.Errorf("unsupported image format %q", format)
+	}
+}
+
+func processJPEG(data []byte, opts ImageOptions) (*ProcessedImage, error) {
+	img, err := jpeg.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("decode jpeg: %w", err)
+	}
+
+	// The orientation lives in the EXIF data we are about to drop, so bake
+	// it into the pixels.
+	info := ImageInfo{Format: "jpeg"}
+	if o := jpegOrientation(data); o > 1 {
+		img = applyOrientation(toRGBA(img), o)
+	}
+	info.OriginalWidth, info.OriginalHeight = img.Bounds().Dx(), img.Bounds().Dy()
+
+	img, info.Resized = resizeToFit(img, opts.MaxWidth, opts.MaxHeight)
+	info.Width, info.Height = img.Bounds().Dx(), img.Bounds().Dy()
+
+	quality := opts.JPEGQuality
+	if quality <= 0 || quality > 100 {
+		quality = DefaultJPEGQuality
+	}
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: quality}); err != nil {
+		return nil, fmt.Errorf("encode jpeg: %w", err)
+	}
+	return &ProcessedImage{ImageInfo: info, Data: buf.Bytes()}, nil
+}
+
+func processPNG(data []byte, opts ImageOptions) (*ProcessedImage, error) {
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("decode png: %w", err)
+	}
+
+	info := ImageInfo{Format: "png"}
+	info.OriginalWidth, info.OriginalHeight = img.Bounds().Dx(), img.Bounds().Dy()
+	img, info.Resized = resizeToFit(img, opts.MaxWidth, opts.MaxHeight)
+	info.Width, info.Height = img.Bounds().Dx(), img.Bounds().Dy()
+
+	var buf bytes.Buffer
+	encoder := png.Encoder{CompressionLevel: png.BestCompression}
+	if err := encoder.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("encode png: %w", err)
+	}
+	return &ProcessedImage{ImageInfo: info, Data: buf.Bytes()}, nil
+}
+
+func processGIF(data []byte, opts ImageOptions) (*ProcessedImage, error) {
+	g, err := gif.DecodeAll(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("decode gif: %w", err)
+	}
+
+	info := ImageInfo{
+		Format:         "gif",
+		OriginalWidth:  g.Config.Width,
+		OriginalHeight: g.Config.Height,
+	}
+
+	w, h := fitSize(g.Config.Width, g.Config.Height, opts.MaxWidth, opts.MaxHeight)
+	if w != g.Config.Width || h != g.Config.Height {
+		// Frames are scaled with nearest neighbour sampling so that they
+		// keep their palettes and disposal semantics.
+		for i, frame := range g.Image {
+			g.Image[i] = scalePaletted(frame, g.Config.Width, g.Config.Height, w, h)
+		}
+		g.Config.Width, g.Config.Height = w, h
+		info.Resized = true
+	}
+	info.Width, info.Height = g.Config.Width, g.Config.Height
+
+	var buf bytes.Buffer
+	if err := gif.EncodeAll(&buf, g); err != nil {
+		return nil, fmt.Errorf("encode gif: %w", err)
+	}
+	return &ProcessedImage{ImageInfo: info, Data: buf.Bytes()}, nil
+}
+
+// fitSize returns the largest size within maxW x maxH that keeps the aspect
+// ratio of w x h, never scaling up.
+func fitSize(w, h, maxW, maxH int) (int, int) {
+	nw, nh := w, h
+	if maxW > 0 && nw > maxW {
+		nh = max(1, nh*maxW/nw)
+		nw = maxW
+	}
+	if maxH > 0 && nh > maxH {
+		nw = max(1, nw*maxH/nh)
+		nh = maxH
+	}
+	return nw, nh
+}
+
+func resizeToFit(img image.Image, maxW, maxH int) (image.Image, bool) {
+	b := img.Bounds()
+	w, h := fitSize(b.Dx(), b.Dy(), maxW, maxH)
+	if w == b.Dx() && h == b.Dy() {
+		return img, false
+	}
+	return scaleArea(toRGBA(img), w, h), true
+}
+
+func toRGBA(img image.Image) *image.RGBA {
+	if rgba, ok := img.(*image.RGBA); ok && rgba.Bounds().Min == (image.Point{}) {
+		return rgba
+	}
+	b := img.Bounds()
+	rgba := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(rgba, rgba.Bounds(), img, b.Min, draw.Src)
+	return rgba
+}
+
+// scaleArea downscales src to w x h by averaging the source pixels covered
+// by each destination pixel.
+func scaleArea(src *image.RGBA, w, h int) *image.RGBA {
+	sw, sh := src.Bounds().Dx(), src.Bounds().Dy()
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+
+	for y := 0; y < h; y++ {
+		y0, y1 := y*sh/h, max((y+1)*sh/h, y*sh/h+1)
+		for x := 0; x < w; x++ {
+			x0, x1 := x*sw/w, max((x+1)*sw/w, x*sw/w+1)
+
+			var r, g, b, a, n uint32
+			for sy := y0; sy < y1; sy++ {
+				row := src.Pix[sy*src.Stride:]
+				for sx := x0; sx < x1; sx++ {
+					p := row[sx*4 : sx*4+4]
+					r += uint32(p[0])
+					g += uint32(p[1])
+					b += uint32(p[2])
+					a += uint32(p[3])
+					n++
+				}
+			}
+
+			d := dst.Pix[y*dst.Stride+x*4:]
+			d[0], d[1], d[2], d[3] = uint8(r/n), uint8(g/n), uint8(b/n), uint8(a/n)
+		}
+	}
+	return dst
+}
+
+// scalePaletted scales a GIF frame, including its offset within the
+// logical screen, from sw x sh to w x h.
+func scalePaletted(src *image.Paletted, sw, sh, w, h int) *image.Paletted {
+	b := src.Bounds()
+	rect := image.Rect(b.Min.X*w/sw, b.Min.Y*h/sh, b.Max.X*w/sw, b.Max.Y*h/sh)
+	if rect.Dx() == 0 {
+		rect.Max.X = rect.Min.X + 1
+	}
+	if rect.Dy() == 0 {
+		rect.Max.Y = rect.Min.Y + 1
+	}
+
+	dst := image.NewPaletted(rect, src.Palette)
+	for y := rect.Min.Y; y < rect.Max.Y; y++ {
+		sy := min(b.Max.Y-1, max(b.Min.Y, y*sh/h))
+		for x := rect.Min.X; x < rect.Max.X; x++ {
+			sx := min(b.Max.X-1, max(b.Min.X, x*sw/w))
+			dst.SetColorIndex(x, y, src.ColorIndexAt(sx, sy))
+		}
+	}
+	return dst
+}
+
+// applyOrientation transforms img according to an EXIF orientation value (2-8).
+func applyOrientation(src *image.RGBA, orientation int) *image.RGBA {
+	sw, sh := src.Bounds().Dx(), src.Bounds().Dy()
+	w, h := sw, sh
+	if orientation >= 5 {
+		w, h = sh, sw
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < sh; y++ {
+		for x := 0; x < sw; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2: // flip horizontal
+				dx, dy = sw-1-x, y
+			case 3: // rotate 180
+				dx, dy = sw-1-x, sh-1-y
+			case 4: // flip vertical
+				dx, dy = x, sh-1-y
+			case 5: // transpose
+				dx, dy = y, x
+			case 6: // rotate 90 clockwise
+				dx, dy = sh-1-y, x
+			case 7: // transverse
+				dx, dy = sh-1-y, sw-1-x
+			case 8: // rotate 90 counter-clockwise
+				dx, dy = y, sw-1-x
+			default:
+				return src
+			}
+			copy(dst.Pix[dy*dst.Stride+dx*4:dy*dst.Stride+dx*4+4], src.Pix[y*src.Stride+x*4:y*src.Stride+x*4+4])
+		}
+	}
+	return dst
+}
+
+// jpegOrientation returns the EXIF orientation of a JPEG image, or 0 if it
+// has none.
+func jpegOrientation(data []byte) int {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 0
+	}
+
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xFF {
+			return 0
+		}
+		marker := data[i+1]
+		if marker == 0xDA || marker == 0xD9 { // start of scan, end of image
+			return 0
+		}
+		size := int(binary.BigEndian.Uint16(data[i+2:]))
+		if size < 2 || i+2+size > len(data) {
+			return 0
+		}
+		segment := data[i+4 : i+2+size]
+		if marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return exifOrientation(segment[6:])
+		}
+		i += 2 + size
+	}
+	return 0
+}
+
+// exifOrientation reads the orientation tag from the first IFD of a TIFF structure.
+func exifOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 0
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 0
+	}
+
+	offset := int(order.Uint32(tiff[4:]))
+	if offset < 8 || offset+2 > len(tiff) {
+		return 0
+	}
+	count := int(order.Uint16(tiff[offset:]))
+	for i := 0; i < count; i++ {
+		entry := offset + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 0
+		}
+		if order.Uint16(tiff[entry:]) == 0x0112 {
+			o := int(order.Uint16(tiff[entry+8:]))
+			if o < 1 || o > 8 {
+				return 0
+			}
+			return o
+		}
+	}
+	return 0
+}
@@ -0,0 +1,155 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: image_test.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:21:31
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 05:17:12
+//
+
+package seesdk
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+// withExifOrientation inserts an EXIF APP1 segment carrying the given
+// orientation right after the SOI marker of a JPEG image.
+func withExifOrientation(t *testing.T, data []byte, orientation uint16) []byte {
+	t.Helper()
+
+	tiff := []byte("MM\x00\x2a\x00\x00\x00\x08")
+	tiff = binary.BigEndian.AppendUint16(tiff, 1)
+	tiff = binary.BigEndian.AppendUint16(tiff, 0x0112)
+	tiff = binary.BigEndian.AppendUint16(tiff, 3)
+	tiff = binary.BigEndian.AppendUint32(tiff, 1)
+	tiff = binary.BigEndian.AppendUint16(tiff, orientation)
+	tiff = append(tiff, 0, 0, 0, 0, 0, 0)
+
+	segment := append([]byte("Exif\x00\x00"), tiff...)
+	app1 := []byte{0xFF, 0xE1}
+	app1 = binary.BigEndian.AppendUint16(app1, uint16(len(segment)+2))
+	app1 = append(app1, segment...)
+
+	out := append([]byte{}, data[:2]...)
+	out = append(out, app1...)
+	return append(out, data[2:]...)
+}
+
+func TestProcessImageJPEG(t *testing.T) {
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 400, 200)), nil); err != nil {
+		t.Fatal(err)
+	}
+	data := withExifOrientation(t, buf.Bytes(), 6)
+	if jpegOrientation(data) != 6 {
+		t.Fatal("Expected orientation 6 in test image")
+	}
+
+	processed, err := ProcessImage(bytes.NewReader(data), ImageOptions{MaxWidth: 50})
+	if err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+
+	if processed.OriginalWidth != 200 || processed.OriginalHeight != 400 {
+		t.Errorf("Expected rotated original size 200x400, got %dx%d", processed.OriginalWidth, processed.OriginalHeight)
+	}
+	if processed.Width != 50 || processed.Height != 100 {
+		t.Errorf("Expected rotated and resized size 50x100, got %dx%d", processed.Width, processed.Height)
+	}
+	if bytes.Contains(processed.Data, []byte("Exif")) {
+		t.Error("Expected EXIF data to be stripped")
+	}
+
+	// Without resizing the original size matches the output.
+	processed, err = ProcessImage(bytes.NewReader(data), ImageOptions{})
+	if err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	if processed.Resized || processed.OriginalWidth != processed.Width || processed.OriginalHeight != processed.Height {
+		t.Errorf("Expected unresized output to match the original size, got %+v", processed.ImageInfo)
+	}
+}
+
+func TestProcessImagePNG(t *testing.T) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewNRGBA(image.Rect(0, 0, 300, 100))); err != nil {
+		t.Fatal(err)
+	}
+
+	processed, err := ProcessImage(&buf, ImageOptions{})
+	if err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	if processed.Resized || processed.Width != 300 || processed.Height != 100 {
+		t.Errorf("Expected unchanged 300x100, got %dx%d", processed.Width, processed.Height)
+	}
+}
+
+func TestProcessImageGIF(t *testing.T) {
+	palette := color.Palette{color.Black, color.White}
+	g := &gif.GIF{
+		Image: []*image.Paletted{
+			image.NewPaletted(image.Rect(0, 0, 200, 200), palette),
+			image.NewPaletted(image.Rect(100, 100, 200, 200), palette),
+		},
+		Delay: []int{10, 10},
+	}
+
+	var buf bytes.Buffer
+	if err := gif.EncodeAll(&buf, g); err != nil {
+		t.Fatal(err)
+	}
+
+	processed, err := ProcessImage(&buf, ImageOptions{MaxWidth: 100, MaxHeight: 100})
+	if err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	if processed.Width != 100 || processed.Height != 100 {
+		t.Errorf("Expected 100x100, got %dx%d", processed.Width, processed.Height)
+	}
+
+	out, err := gif.DecodeAll(bytes.NewReader(processed.Data))
+	if err != nil {
+		t.Fatal("Expected valid gif, got:", err)
+	}
+	if len(out.Image) != 2 {
+		t.Errorf("Expected 2 frames, got %d", len(out.Image))
+	}
+	if got := out.Image[1].Bounds(); got != image.Rect(50, 50, 100, 100) {
+		t.Errorf("Expected second frame at (50,50)-(100,100), got %v", got)
+	}
+}
+
+func TestFitSize(t *testing.T) {
+	tests := []struct {
+		w, h, maxW, maxH int
+		wantW, wantH     int
+	}{
+		{100, 50, 0, 0, 100, 50},
+		{100, 50, 200, 200, 100, 50},
+		{100, 50, 50, 0, 50, 25},
+		{100, 50, 0, 10, 20, 10},
+		{1000, 10, 10, 10, 10, 1},
+	}
+
+	for _, tt := range tests {
+		w, h := fitSize(tt.w, tt.h, tt.maxW, tt.maxH)
+		if w != tt.wantW || h != tt.wantH {
+			t.Errorf("fitSize(%d, %d, %d, %d) = %dx%d, want %dx%d",
+				tt.w, tt.h, tt.maxW, tt.maxH, w, h, tt.wantW, tt.wantH)
+		}
+	}
+}
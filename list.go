@@ -0,0 +1,226 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: list.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:24:32
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 05:12:18
+//
+
+package seesdk
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// DefaultPageSize is the number of items requested per page when listing.
+const DefaultPageSize = 50
+
+// ListOptions contains filters and paging for list requests. Zero values
+// disable the corresponding filter.
+type ListOptions struct {
+	Domain        string
+	TagID         int64
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	Query         string // Text search over slug, title and target
+
+	// Page is the 1-based page to fetch (default: 1). Iterators start here.
+	Page     int
+	PageSize int // default: DefaultPageSize
+}
+
+func (o ListOptions) values() url.Values {
+	query := url.Values{}
+	if o.Domain != "" {
+		query.Set("domain", o.Domain)
+	}
+	if o.TagID != 0 {
+		query.Set("tag_id", strconv.FormatInt(o.TagID, 10))
+	}
+	if !o.CreatedAfter.IsZero() {
+		query.Set("created_after", strconv.FormatInt(o.CreatedAfter.Unix(), 10))
+	}
+	if !o.CreatedBefore.IsZero() {
+		query.Set("created_before", strconv.FormatInt(o.CreatedBefore.Unix(), 10))
+	}
+	if o.Query != "" {
+		query.Set("q", o.Query)
+	}
+
+	page, pageSize := o.Page, o.PageSize
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	query.Set("page", strconv.Itoa(page))
+	query.Set("page_size", strconv.Itoa(pageSize))
+	return query
+}
+
+// ListShortURLs retrieves one page of short URLs matching opts.
+func (c *Client) ListShortURLs(ctx context.Context, opts ListOptions) (*ListShortURLsResponse, error) {
+	respBody, err := c.doRequestContext(ctx, "GET", "/shorten/list?"+opts.values().Encode(), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response ListShortURLsResponse
+	if err := unmarshalResponse(respBody, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// ListTexts retrieves one page of texts matching opts.
+func (c *Client) ListTexts(ctx context.Context, opts ListOptions) (*ListTextsResponse, error) {
+	respBody, err := c.doRequestContext(ctx, "GET", "/text/list?"+opts.values().Encode(), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response ListTextsResponse
+	if err := unmarshalResponse(respBody, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// ListFiles retrieves one page of uploaded files matching opts.
+func (c *Client) ListFiles(ctx context.Context, opts ListOptions) (*ListFilesResponse, error) {
+	respBody, err := c.doRequestContext(ctx, "GET", "/file/list?"+opts.values().Encode(), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response ListFilesResponse
+	if err := unmarshalResponse(respBody, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// ShortURLs returns an iterator over all short URLs matching opts.
+func (c *Client) ShortURLs(ctx context.Context, opts ListOptions) *Iterator[ShortURL] {
+	return newIterator(ctx, opts, func(ctx context.Context, opts ListOptions) (*ListPage[ShortURL], error) {
+		resp, err := c.ListShortURLs(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		return &resp.Data, nil
+	})
+}
+
+// Texts returns an iterator over all texts matching opts.
+func (c *Client) Texts(ctx context.Context, opts ListOptions) *Iterator[Text] {
+	return newIterator(ctx, opts, func(ctx context.Context, opts ListOptions) (*ListPage[Text], error) {
+		resp, err := c.ListTexts(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		return &resp.Data, nil
+	})
+}
+
+// Files returns an iterator over all uploaded files matching opts.
+func (c *Client) Files(ctx context.Context, opts ListOptions) *Iterator[File] {
+	return newIterator(ctx, opts, func(ctx context.Context, opts ListOptions) (*ListPage[File], error) {
+		resp, err := c.ListFiles(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		return &resp.Data, nil
+	})
+}
+
+// Iterator pages transparently through a list endpoint.
+//
+//	it := client.ShortURLs(ctx, seesdk.ListOptions{Domain: "s.ee"})
+//	for it.Next() {
+//		link := it.Value()
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type Iterator[T any] struct {
+	ctx   context.Context
+	opts  ListOptions
+	fetch func(context.Context, ListOptions) (*ListPage[T], error)
+
+	items []T
+	index int
+	value T
+	seen  int // items up to the end of the current page
+	done  bool
+	err   error
+}
+
+func newIterator[T any](ctx context.Context, opts ListOptions, fetch func(context.Context, ListOptions) (*ListPage[T], error)) *Iterator[T] {
+	if opts.Page <= 0 {
+		opts.Page = 1
+	}
+	if opts.PageSize <= 0 {
+		opts.PageSize = DefaultPageSize
+	}
+	return &Iterator[T]{ctx: ctx, opts: opts, fetch: fetch}
+}
+
+// Next advances to the next item, fetching the next page when needed. It
+// returns false when there are no more items or an error occurred.
+func (it *Iterator[T]) Next() bool {
+	for it.index >= len(it.items) {
+		if it.done || it.err != nil {
+			return false
+		}
+
+		page, err := it.fetch(it.ctx, it.opts)
+		if err != nil {
+			it.err = err
+			return false
+		}
+
+		// The server may cap the page size, so use the one it returned.
+		pageSize := page.PageSize
+		if pageSize <= 0 {
+			pageSize = it.opts.PageSize
+		}
+		if it.seen == 0 {
+			it.seen = (it.opts.Page - 1) * pageSize
+		}
+		it.seen += len(page.Items)
+
+		it.items, it.index = page.Items, 0
+		if len(page.Items) == 0 || len(page.Items) < pageSize ||
+			(page.Total > 0 && it.seen >= page.Total) {
+			it.done = true
+		}
+		it.opts.Page++
+	}
+
+	it.value = it.items[it.index]
+	it.index++
+	return true
+}
+
+// Value returns the current item.
+func (it *Iterator[T]) Value() T {
+	return it.value
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
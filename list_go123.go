@@ -0,0 +1,41 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: list_go123.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:24:32
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 04:24:32
+//
+
+//go:build go1.23
+
+package seesdk
+
+import "iter"
+
+// All returns a range-over-func sequence of the remaining items. Iteration
+// stops after yielding an error.
+//
+//	for link, err := range client.ShortURLs(ctx, opts).All() {
+//		if err != nil {
+//			return err
+//		}
+//	}
+func (it *Iterator[T]) All() iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for it.Next() {
+			if !yield(it.Value(), nil) {
+				return
+			}
+		}
+		if err := it.Err(); err != nil {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: list_test.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:24:42
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 05:12:18
+//
+
+package seesdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func TestShortURLsIterator(t *testing.T) {
+	const total = 7
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/shorten/list" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("domain") != "s.ee" {
+			t.Errorf("Expected domain filter, got query %s", r.URL.RawQuery)
+		}
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		pageSize, _ := strconv.Atoi(r.URL.Query().Get("page_size"))
+
+		items := ""
+		for i := (page - 1) * pageSize; i < min(page*pageSize, total); i++ {
+			if items != "" {
+				items += ","
+			}
+			items += fmt.Sprintf(`{"slug":"s%d"}`, i)
+		}
+		fmt.Fprintf(w, `{"code":200,"data":{"items":[%s],"page":%d,"page_size":%d,"total":%d}}`,
+			items, page, pageSize, total)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{BaseURL: server.URL})
+	it := client.ShortURLs(context.Background(), ListOptions{Domain: "s.ee", PageSize: 3})
+
+	var slugs []string
+	for it.Next() {
+		slugs = append(slugs, it.Value().Slug)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+
+	if len(slugs) != total || slugs[0] != "s0" || slugs[total-1] != "s6" {
+		t.Errorf("Expected slugs s0..s6, got %v", slugs)
+	}
+}
+
+func TestIteratorCappedPageSize(t *testing.T) {
+	const total, maxPageSize = 7, 2
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+
+		items := ""
+		for i := (page - 1) * maxPageSize; i < min(page*maxPageSize, total); i++ {
+			if items != "" {
+				items += ","
+			}
+			items += fmt.Sprintf(`{"slug":"t%d"}`, i)
+		}
+		fmt.Fprintf(w, `{"code":200,"data":{"items":[%s],"page":%d,"page_size":%d,"total":%d}}`,
+			items, page, maxPageSize, total)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{BaseURL: server.URL})
+	it := client.Texts(context.Background(), ListOptions{PageSize: 5})
+
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	if count != total {
+		t.Errorf("Expected %d items from a server capping the page size, got %d", total, count)
+	}
+}
@@ -0,0 +1,118 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: lru.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:43:20
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 05:15:24
+//
+
+package seesdk
+
+import (
+	"container/list"
+	"sync"
+)
+
+// lruCache is a concurrency-safe cache that evicts the least recently used
+// entry once it holds size entries.
+type lruCache[K comparable, V any] struct {
+	size int
+
+	mu    sync.Mutex
+	order *list.List // of *lruEntry[K, V], most recently used first
+	items map[K]*list.Element
+}
+
+type lruEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+func newLRUCache[K comparable, V any](size int) *lruCache[K, V] {
+	return &lruCache[K, V]{size: max(size, 1), order: list.New(), items: map[K]*list.Element{}}
+}
+
+// Get returns the value for key and marks it as recently used.
+func (c *lruCache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.items[key]; ok {
+		c.order.MoveToFront(elem)
+		return elem.Value.(*lruEntry[K, V]).value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Add sets the value for key, evicting the least recently used entry if
+// the cache is full.
+func (c *lruCache[K, V]) Add(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.addLocked(key, value)
+}
+
+// GetOrAdd returns the value for key if present, or adds value and returns
+// it. The result reports whether the value was already present.
+func (c *lruCache[K, V]) GetOrAdd(key K, value V) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.items[key]; ok {
+		c.order.MoveToFront(elem)
+		return elem.Value.(*lruEntry[K, V]).value, true
+	}
+	c.addLocked(key, value)
+	return value, false
+}
+
+// Remove deletes key from the cache.
+func (c *lruCache[K, V]) Remove(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.items[key]; ok {
+		c.order.Remove(elem)
+		delete(c.items, key)
+	}
+}
+
+// RemoveFunc deletes key from the cache if match reports true for its value.
+func (c *lruCache[K, V]) RemoveFunc(key K, match func(V) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.items[key]; ok && match(elem.Value.(*lruEntry[K, V]).value) {
+		c.order.Remove(elem)
+		delete(c.items, key)
+	}
+}
+
+// Len returns the number of entries.
+func (c *lruCache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+func (c *lruCache[K, V]) addLocked(key K, value V) {
+	if elem, ok := c.items[key]; ok {
+		elem.Value.(*lruEntry[K, V]).value = value
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	c.items[key] = c.order.PushFront(&lruEntry[K, V]{key: key, value: value})
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*lruEntry[K, V]).key)
+	}
+}
@@ -0,0 +1,49 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: lru_test.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:43:40
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 05:15:24
+//
+
+package seesdk
+
+import "testing"
+
+func TestLRUCache(t *testing.T) {
+	c := newLRUCache[string, int](2)
+	c.Add("a", 1)
+	c.Add("b", 2)
+	c.Get("a")
+	c.Add("c", 3) // evicts b
+
+	if _, ok := c.Get("b"); ok {
+		t.Error("Expected b to be evicted")
+	}
+	if v, ok := c.Get("a"); !ok || v != 1 {
+		t.Errorf("Expected a=1, got %v, %v", v, ok)
+	}
+	if v, found := c.GetOrAdd("c", 30); !found || v != 3 {
+		t.Errorf("Expected existing c=3, got %v, %v", v, found)
+	}
+
+	c.RemoveFunc("c", func(v int) bool { return v == 30 })
+	if _, ok := c.Get("c"); !ok {
+		t.Error("Expected c to be kept when its value does not match")
+	}
+	c.RemoveFunc("c", func(v int) bool { return v == 3 })
+	if _, ok := c.Get("c"); ok {
+		t.Error("Expected c to be removed")
+	}
+
+	c.Remove("a")
+	if c.Len() != 0 {
+		t.Errorf("Expected 0 entries, got %d", c.Len())
+	}
+}
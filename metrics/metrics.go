@@ -0,0 +1,271 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: metrics.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:39:17
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 04:39:17
+//
+
+// Package metrics exposes API usage and client request metrics in the
+// Prometheus text exposition format without external dependencies.
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	seesdk "github.com/sdotee/sdk.go"
+)
+
+// DefaultBuckets are the default request latency histogram buckets in seconds.
+var DefaultBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// DefaultUsageTTL is the default time GetUsage results are reused between scrapes.
+const DefaultUsageTTL = time.Minute
+
+// Options contains options for a Collector.
+type Options struct {
+	// Namespace prefixes all metric names (default: "see").
+	Namespace string
+	// Buckets are the latency histogram upper bounds in seconds
+	// (default: DefaultBuckets).
+	Buckets []float64
+	// UsageTTL is how long usage is cached so that frequent scrapes do not
+	// consume API quota (default: DefaultUsageTTL).
+	UsageTTL time.Duration
+}
+
+type requestKey struct {
+	method string
+	route  string
+	code   string
+}
+
+type routeKey struct {
+	method string
+	route  string
+}
+
+type histogram struct {
+	counts []uint64 // per bucket, not cumulative
+	sum    float64
+	count  uint64
+}
+
+// Collector records client request metrics and serves them, together with
+// the account usage, as an http.Handler.
+type Collector struct {
+	client *seesdk.Client
+	opts   Options
+
+	mu        sync.Mutex
+	requests  map[requestKey]uint64
+	durations map[routeKey]*histogram
+
+	usageMu      sync.Mutex
+	usage        *seesdk.Usage
+	usageFetched time.Time
+}
+
+// New creates a Collector and installs it as the client's request observer,
+// chaining any observer already set. Call it before the client is used.
+func New(client *seesdk.Client, opts Options) *Collector {
+	if opts.Namespace == "" {
+		opts.Namespace = "see"
+	}
+	if len(opts.Buckets) == 0 {
+		opts.Buckets = DefaultBuckets
+	}
+	opts.Buckets = append([]float64(nil), opts.Buckets...)
+	sort.Float64s(opts.Buckets)
+	if opts.UsageTTL <= 0 {
+		opts.UsageTTL = DefaultUsageTTL
+	}
+
+	c := &Collector{
+		client:    client,
+		opts:      opts,
+		requests:  map[requestKey]uint64{},
+		durations: map[routeKey]*histogram{},
+	}
+
+	prev := client.Observer
+	client.Observer = func(info seesdk.RequestInfo) {
+		if prev != nil {
+			prev(info)
+		}
+		c.Observe(info)
+	}
+	return c
+}
+
+// Observe records a completed request.
+func (c *Collector) Observe(info seesdk.RequestInfo) {
+	code := "error"
+	if info.StatusCode != 0 {
+		code = strconv.Itoa(info.StatusCode)
+	}
+	seconds := info.Duration.Seconds()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.requests[requestKey{info.Method, info.Route, code}]++
+
+	key := routeKey{info.Method, info.Route}
+	h, ok := c.durations[key]
+	if !ok {
+		h = &histogram{counts: make([]uint64, len(c.opts.Buckets))}
+		c.durations[key] = h
+	}
+	for i, bound := range c.opts.Buckets {
+		if seconds <= bound {
+			h.counts[i]++
+			break
+		}
+	}
+	h.sum += seconds
+	h.count++
+}
+
+// ServeHTTP writes all metrics in the Prometheus text exposition format.
+func (c *Collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	c.WriteMetrics(w)
+}
+
+// WriteMetrics writes all metrics in the Prometheus text exposition format.
+// Usage gauges are omitted if usage cannot be fetched, which is reported by
+// the usage_up gauge.
+func (c *Collector) WriteMetrics(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	ns := c.opts.Namespace
+
+	usage, err := c.fetchUsage()
+	writeHeader(bw, ns+"_usage_up", "gauge", "Whether the last usage request succeeded.")
+	fmt.Fprintf(bw, "%s_usage_up %d\n", ns, boolToInt(err == nil))
+	if usage != nil {
+		counters := usage.Counters()
+		writeHeader(bw, ns+"_usage_count", "gauge", "Current value of an API usage counter.")
+		for _, counter := range counters {
+			fmt.Fprintf(bw, "%s_usage_count{counter=%s} %d\n", ns, quote(string(counter.Counter)), counter.Used)
+		}
+		writeHeader(bw, ns+"_usage_limit", "gauge", "Limit of an API usage counter, +Inf if unlimited.")
+		for _, counter := range counters {
+			limit := float64(counter.Limit)
+			if counter.Unlimited() {
+				limit = math.Inf(1)
+			}
+			fmt.Fprintf(bw, "%s_usage_limit{counter=%s} %s\n", ns, quote(string(counter.Counter)), formatFloat(limit))
+		}
+	}
+
+	c.mu.Lock()
+	requests := make([]requestKey, 0, len(c.requests))
+	for key := range c.requests {
+		requests = append(requests, key)
+	}
+	sort.Slice(requests, func(i, j int) bool {
+		a, b := requests[i], requests[j]
+		if a.route != b.route {
+			return a.route < b.route
+		}
+		if a.method != b.method {
+			return a.method < b.method
+		}
+		return a.code < b.code
+	})
+	writeHeader(bw, ns+"_client_requests_total", "counter", "API requests made by the client.")
+	for _, key := range requests {
+		fmt.Fprintf(bw, "%s_client_requests_total{method=%s,route=%s,code=%s} %d\n",
+			ns, quote(key.method), quote(key.route), quote(key.code), c.requests[key])
+	}
+
+	routes := make([]routeKey, 0, len(c.durations))
+	for key := range c.durations {
+		routes = append(routes, key)
+	}
+	sort.Slice(routes, func(i, j int) bool {
+		if routes[i].route != routes[j].route {
+			return routes[i].route < routes[j].route
+		}
+		return routes[i].method < routes[j].method
+	})
+	name := ns + "_client_request_duration_seconds"
+	writeHeader(bw, name, "histogram", "Latency of API requests made by the client.")
+	for _, key := range routes {
+		h := c.durations[key]
+		labels := "method=" + quote(key.method) + ",route=" + quote(key.route)
+		var cumulative uint64
+		for i, bound := range c.opts.Buckets {
+			cumulative += h.counts[i]
+			fmt.Fprintf(bw, "%s_bucket{%s,le=%s} %d\n", name, labels, quote(formatFloat(bound)), cumulative)
+		}
+		fmt.Fprintf(bw, "%s_bucket{%s,le=\"+Inf\"} %d\n", name, labels, h.count)
+		fmt.Fprintf(bw, "%s_sum{%s} %s\n", name, labels, formatFloat(h.sum))
+		fmt.Fprintf(bw, "%s_count{%s} %d\n", name, labels, h.count)
+	}
+	c.mu.Unlock()
+
+	return bw.Flush()
+}
+
+// fetchUsage returns the cached usage, refreshing it when it is older than
+// the TTL. On error the previous usage is returned along with the error.
+func (c *Collector) fetchUsage() (*seesdk.Usage, error) {
+	c.usageMu.Lock()
+	defer c.usageMu.Unlock()
+
+	if c.usage != nil && time.Since(c.usageFetched) < c.opts.UsageTTL {
+		return c.usage, nil
+	}
+	resp, err := c.client.GetUsage()
+	if err != nil {
+		return c.usage, err
+	}
+	c.usage = &resp.Data
+	c.usageFetched = time.Now()
+	return c.usage, nil
+}
+
+func writeHeader(w io.Writer, name, typ, help string) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, typ)
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// quote quotes a label value as required by the exposition format.
+func quote(value string) string {
+	return `"` + labelEscaper.Replace(value) + `"`
+}
+
+func formatFloat(f float64) string {
+	switch {
+	case math.IsInf(f, 1):
+		return "+Inf"
+	case math.IsInf(f, -1):
+		return "-Inf"
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
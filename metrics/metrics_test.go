@@ -0,0 +1,82 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: metrics_test.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:39:30
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 04:39:30
+//
+
+package metrics
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	seesdk "github.com/sdotee/sdk.go"
+)
+
+func TestCollector(t *testing.T) {
+	usageRequests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/usage":
+			usageRequests++
+			w.Write([]byte(`{"code":200,"data":{"link_count_day":12,"link_count_day_limit":100,"api_count_day_limit":-1}}`))
+		case "/file/delete/abc123":
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := seesdk.NewClient(seesdk.Config{BaseURL: server.URL})
+	collector := New(client, Options{Buckets: []float64{0.5, 1}})
+
+	client.DeleteFile("abc123")
+	collector.Observe(seesdk.RequestInfo{Method: "POST", Route: "/shorten", Duration: 750 * time.Millisecond, Err: errors.New("timeout")})
+
+	rec := httptest.NewRecorder()
+	collector.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	body, _ := io.ReadAll(rec.Body)
+	out := string(body)
+
+	for _, want := range []string{
+		"# TYPE see_usage_count gauge\n",
+		`see_usage_up 1`,
+		`see_usage_count{counter="link_day"} 12`,
+		`see_usage_limit{counter="link_day"} 100`,
+		`see_usage_limit{counter="api_day"} +Inf`,
+		`see_client_requests_total{method="GET",route="/file/delete/{key}",code="404"} 1`,
+		`see_client_requests_total{method="GET",route="/usage",code="200"} 1`,
+		`see_client_requests_total{method="POST",route="/shorten",code="error"} 1`,
+		`see_client_request_duration_seconds_bucket{method="POST",route="/shorten",le="0.5"} 0`,
+		`see_client_request_duration_seconds_bucket{method="POST",route="/shorten",le="1"} 1`,
+		`see_client_request_duration_seconds_bucket{method="POST",route="/shorten",le="+Inf"} 1`,
+		`see_client_request_duration_seconds_sum{method="POST",route="/shorten"} 0.75`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected output to contain %q, got:\n%s", want, out)
+		}
+	}
+
+	collector.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/metrics", nil))
+	if usageRequests != 1 {
+		t.Errorf("Expected usage to be cached between scrapes, got %d requests", usageRequests)
+	}
+}
+
+func TestQuote(t *testing.T) {
+	if got := quote("a\"b\\c\nd"); got != `"a\"b\\c\nd"` {
+		t.Errorf("Expected escaped label, got %s", got)
+	}
+}
@@ -1,5 +1,5 @@
 //
-// Copyright (c) 2025 S.EE Development Team
+// Copyright (c) 2025-2026 S.EE Development Team
 //
 // This source code is licensed under the MIT License,
 // which is located in the LICENSE file in the source tree's root directory.
@@ -9,73 +9,87 @@
 // File Created: 2025-11-28 11:26:17
 //
 // Modified By: S.EE Development Team <dev@s.ee>
-// Last Modified: 2025-12-04 17:59:18
+// Last Modified: 2026-10-19 05:03:11
 //
 
 package seesdk
 
+import "encoding/json"
+
 // CreateShortURLRequest represents a request to create a short URL.
 type CreateShortURLRequest struct {
 	CustomSlug            string  `json:"custom_slug,omitempty"`
-	Domain                string  `json:"domain"`
+	Domain                string  `json:"domain"` // default: DomainCatalog().DefaultFor(DomainKindShortURL)
 	ExpirationRedirectURL string  `json:"expiration_redirect_url,omitempty"`
-	ExpireAt              int64   `json:"expire_at,omitempty"` // Unix timestamp in seconds
+	ExpireAt              Expiry  `json:"expire_at,omitempty"` // Unix timestamp in seconds
 	Password              string  `json:"password,omitempty"`
 	TagIDs                []int64 `json:"tag_ids,omitempty"`
 	TargetURL             string  `json:"target_url"`
 	Title                 string  `json:"title,omitempty"`
+
+	// TagNames are resolved to IDs by the client and merged into TagIDs.
+	TagNames []string `json:"-"`
+	// IdempotencyKey is sent as the Idempotency-Key header. Retries with
+	// the same key return the original response; ExpireAt may differ.
+	IdempotencyKey string `json:"-"`
 }
 
+// CreateTextRequest represents a request to create a text.
 type CreateTextRequest struct {
 	Content    string  `json:"content"`
 	CustomSlug string  `json:"custom_slug,omitempty"`
 	Domain     string  `json:"domain,omitempty"`
-	ExpireAt   int64   `json:"expire_at,omitempty"` // Unix timestamp in seconds
+	ExpireAt   Expiry  `json:"expire_at,omitempty"` // Unix timestamp in seconds
 	Password   string  `json:"password,omitempty"`
 	TagIDs     []int64 `json:"tag_ids,omitempty"`
 	TextType   string  `json:"text_type,omitempty"`
 	Title      string  `json:"title,omitempty"`
+
+	// TagNames are resolved to IDs by the client and merged into TagIDs.
+	TagNames []string `json:"-"`
+	// IdempotencyKey is sent as the Idempotency-Key header. Retries with
+	// the same key return the original response; ExpireAt may differ.
+	IdempotencyKey string `json:"-"`
 }
 
-// CreateShortURLResponse represents the response from creating a short URL.
-type CreateShortURLResponse struct {
-	Code int `json:"code"`
-	Data struct {
-		CustomSlug string `json:"custom_slug"`
-		ShortURL   string `json:"short_url"`
-		Slug       string `json:"slug"`
-	} `json:"data"`
+// Response is the envelope of API responses. Data holds the typed payload.
+type Response[T any] struct {
+	Code    int    `json:"code"`
+	Data    T      `json:"data"`
 	Message string `json:"message"`
 }
 
-type CreateTextResponse struct {
-	Code int `json:"code"`
-	Data struct {
-		CustomSlug string `json:"custom_slug"`
-		ShortURL   string `json:"short_url"`
-		Slug       string `json:"slug"`
-	}
-	Message string `json:"message"`
+// ShortURLData contains the slug and URL of a created short URL or text.
+type ShortURLData struct {
+	CustomSlug string `json:"custom_slug"`
+	ShortURL   string `json:"short_url"`
+	Slug       string `json:"slug"`
 }
 
+// CreateShortURLResponse represents the response from creating a short URL.
+type CreateShortURLResponse = Response[ShortURLData]
+
+// CreateTextResponse represents the response from creating a text.
+type CreateTextResponse = Response[ShortURLData]
+
 // GetUsageResponse represents the response containing usage statistics.
-type GetUsageResponse struct {
-	Code int `json:"code"`
-	Data struct {
-		APICountDay           int `json:"api_count_day"`
-		APICountDayLimit      int `json:"api_count_day_limit"`
-		APICountMonth         int `json:"api_count_month"`
-		APICountMonthLimit    int `json:"api_count_month_limit"`
-		LinkCountDay          int `json:"link_count_day"`
-		LinkCountDayLimit     int `json:"link_count_day_limit"`
-		LinkCountMonth        int `json:"link_count_month"`
-		LinkCountMonthLimit   int `json:"link_count_month_limit"`
-		QRCodeCountDay        int `json:"qrcode_count_day"`
-		QRCodeCountDayLimit   int `json:"qrcode_count_day_limit"`
-		QRCodeCountMonth      int `json:"qrcode_count_month"`
-		QRCodeCountMonthLimit int `json:"qrcode_count_month_limit"`
-	} `json:"data"`
-	Message string `json:"message"`
+type GetUsageResponse = Response[Usage]
+
+// Usage contains API usage counters and their limits. A limit of
+// UsageNoLimit means the counter is unlimited.
+type Usage struct {
+	APICountDay           int `json:"api_count_day"`
+	APICountDayLimit      int `json:"api_count_day_limit"`
+	APICountMonth         int `json:"api_count_month"`
+	APICountMonthLimit    int `json:"api_count_month_limit"`
+	LinkCountDay          int `json:"link_count_day"`
+	LinkCountDayLimit     int `json:"link_count_day_limit"`
+	LinkCountMonth        int `json:"link_count_month"`
+	LinkCountMonthLimit   int `json:"link_count_month_limit"`
+	QRCodeCountDay        int `json:"qrcode_count_day"`
+	QRCodeCountDayLimit   int `json:"qrcode_count_day_limit"`
+	QRCodeCountMonth      int `json:"qrcode_count_month"`
+	QRCodeCountMonthLimit int `json:"qrcode_count_month_limit"`
 }
 
 // DeleteURLRequest represents a request to delete a short URL.
@@ -91,45 +105,53 @@ type DeleteTextRequest struct {
 }
 
 // DeleteURLResponse represents the response from deleting a short URL.
-type DeleteURLResponse struct {
-	Code    int    `json:"code"`
-	Data    any    `json:"data,omitempty"`
-	Message string `json:"message"`
-}
+type DeleteURLResponse = Response[any]
 
 // DeleteTextResponse represents the response from deleting a text.
-type DeleteTextResponse struct {
-	Code    int    `json:"code"`
-	Data    any    `json:"data,omitempty"`
-	Message string `json:"message"`
-}
+type DeleteTextResponse = Response[any]
 
 // UpdateShortURLRequest represents a request to update a short URL.
+// Optional fields are left unchanged unless set or cleared.
 type UpdateShortURLRequest struct {
-	Domain    string `json:"domain"`
-	Slug      string `json:"slug"`
-	TargetURL string `json:"target_url"`
-	Title     string `json:"title"`
+	Domain                string            `json:"domain"`
+	Slug                  string            `json:"slug"`
+	TargetURL             string            `json:"target_url,omitempty"` // Empty leaves the target unchanged
+	Title                 Optional[string]  `json:"title"`
+	ExpireAt              Optional[Expiry]  `json:"expire_at"` // Clear to never expire
+	ExpirationRedirectURL Optional[string]  `json:"expiration_redirect_url"`
+	Password              Optional[string]  `json:"password"` // Clear to remove the password
+	TagIDs                Optional[[]int64] `json:"tag_ids"`
+}
+
+// MarshalJSON encodes the request, omitting fields that are left unchanged.
+func (r UpdateShortURLRequest) MarshalJSON() ([]byte, error) {
+	p := patch{"domain": r.Domain, "slug": r.Slug}
+	p.nonEmpty("target_url", r.TargetURL)
+	p.optional("title", r.Title)
+	p.optional("expire_at", r.ExpireAt)
+	p.optional("expiration_redirect_url", r.ExpirationRedirectURL)
+	p.optional("password", r.Password)
+	p.optional("tag_ids", r.TagIDs)
+	return json.Marshal(p)
 }
 
 // UploadFileResponse represents the response from uploading a file.
-type UploadFileResponse struct {
-	Code int `json:"code"`
-	Data struct {
-		Delete       string `json:"delete"`
-		FileID       int    `json:"file_id"`
-		Filename     string `json:"filename"`
-		Hash         string `json:"hash"`
-		Height       int    `json:"height"`
-		Page         string `json:"page"`
-		Path         string `json:"path"`
-		Size         int    `json:"size"`
-		Storename    string `json:"storename"`
-		UploadStatus int    `json:"upload_status"`
-		URL          string `json:"url"`
-		Width        int    `json:"width"`
-	} `json:"data"`
-	Message string `json:"message"`
+type UploadFileResponse = Response[UploadedFile]
+
+// UploadedFile contains the details of an uploaded file.
+type UploadedFile struct {
+	Delete       string `json:"delete"`
+	FileID       int    `json:"file_id"`
+	Filename     string `json:"filename"`
+	Hash         string `json:"hash"`
+	Height       int    `json:"height"`
+	Page         string `json:"page"`
+	Path         string `json:"path"`
+	Size         int    `json:"size"`
+	Storename    string `json:"storename"`
+	UploadStatus int    `json:"upload_status"`
+	URL          string `json:"url"`
+	Width        int    `json:"width"`
 }
 
 // DeleteFileResponse represents the response from deleting a file.
@@ -139,34 +161,43 @@ type DeleteFileResponse struct {
 	Success bool   `json:"success"`
 }
 
+// UpdateTextRequest represents a request to update a text.
+// Optional fields are left unchanged unless set or cleared.
 type UpdateTextRequest struct {
-	Domain  string `json:"domain"`
-	Slug    string `json:"slug"`
-	Content string `json:"content"`
-	Title   string `json:"title,omitempty"`
+	Domain   string            `json:"domain"`
+	Slug     string            `json:"slug"`
+	Content  string            `json:"content,omitempty"` // Empty leaves the content unchanged
+	Title    Optional[string]  `json:"title"`
+	TextType Optional[string]  `json:"text_type"` // Clear to reset syntax highlighting
+	ExpireAt Optional[Expiry]  `json:"expire_at"` // Clear to never expire
+	Password Optional[string]  `json:"password"`  // Clear to remove the password
+	TagIDs   Optional[[]int64] `json:"tag_ids"`
 }
 
-// UpdateShortURLResponse represents the response from updating a short URL.
-type UpdateShortURLResponse struct {
-	Code    int    `json:"code"`
-	Data    any    `json:"data"`
-	Message string `json:"message"`
+// MarshalJSON encodes the request, omitting fields that are left unchanged.
+func (r UpdateTextRequest) MarshalJSON() ([]byte, error) {
+	p := patch{"domain": r.Domain, "slug": r.Slug}
+	p.nonEmpty("content", r.Content)
+	p.optional("title", r.Title)
+	p.optional("text_type", r.TextType)
+	p.optional("expire_at", r.ExpireAt)
+	p.optional("password", r.Password)
+	p.optional("tag_ids", r.TagIDs)
+	return json.Marshal(p)
 }
 
+// UpdateShortURLResponse represents the response from updating a short URL.
+type UpdateShortURLResponse = Response[any]
+
 // UpdateTextResponse represents the response from updating a text.
-type UpdateTextResponse struct {
-	Code    int    `json:"code"`
-	Data    any    `json:"data,omitempty"`
-	Message string `json:"message"`
-}
+type UpdateTextResponse = Response[any]
 
 // DomainsResponse represents the response containing available domains.
-type DomainsResponse struct {
-	Code int `json:"code"`
-	Data struct {
-		Domains []string `json:"domains"`
-	} `json:"data"`
-	Message string `json:"message"`
+type DomainsResponse = Response[DomainList]
+
+// DomainList contains a list of domain names.
+type DomainList struct {
+	Domains []string `json:"domains"`
 }
 
 // Tag represents a tag entity.
@@ -176,10 +207,239 @@ type Tag struct {
 }
 
 // TagsResponse represents the response containing available tags.
-type TagsResponse struct {
-	Code int `json:"code"`
-	Data struct {
-		Tags []Tag `json:"tags"`
-	} `json:"data"`
-	Message string `json:"message"`
+type TagsResponse = Response[TagList]
+
+// TagList contains a list of tags.
+type TagList struct {
+	Tags []Tag `json:"tags"`
+}
+
+// TextContentResponse represents the response containing the content of a text.
+type TextContentResponse = Response[TextContent]
+
+// TextContent contains the content of a text.
+type TextContent struct {
+	Content  string `json:"content"`
+	TextType string `json:"text_type"`
+	Title    string `json:"title"`
+}
+
+// CreateTagRequest represents a request to create a tag.
+type CreateTagRequest struct {
+	Name string `json:"name"`
+}
+
+// RenameTagRequest represents a request to rename a tag.
+type RenameTagRequest struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+// DeleteTagRequest represents a request to delete a tag.
+type DeleteTagRequest struct {
+	ID int64 `json:"id"`
+}
+
+// MergeTagsRequest represents a request to merge one tag into another.
+type MergeTagsRequest struct {
+	SourceID int64 `json:"source_id"`
+	TargetID int64 `json:"target_id"`
+}
+
+// TagResponse represents the response containing a single tag.
+type TagResponse = Response[Tag]
+
+// DeleteTagResponse represents the response from deleting a tag.
+type DeleteTagResponse = Response[any]
+
+// MergeTagsResponse represents the response from merging tags.
+type MergeTagsResponse = Response[MergeTagsResult]
+
+// MergeTagsResult contains the outcome of merging two tags.
+type MergeTagsResult struct {
+	Retagged int `json:"retagged"` // Number of links moved to the target tag
+	Tag      Tag `json:"tag"`
+}
+
+// ShortURL represents a short URL and its current settings.
+type ShortURL struct {
+	Domain                string    `json:"domain"`
+	Slug                  string    `json:"slug"`
+	CustomSlug            string    `json:"custom_slug"`
+	ShortURL              string    `json:"short_url"`
+	TargetURL             string    `json:"target_url"`
+	Title                 string    `json:"title"`
+	ExpireAt              Expiry    `json:"expire_at"` // 0 if never
+	ExpirationRedirectURL string    `json:"expiration_redirect_url"`
+	HasPassword           bool      `json:"has_password"`
+	Tags                  []Tag     `json:"tags"`
+	Visits                int64     `json:"visits"`
+	CreatedAt             Timestamp `json:"created_at"`
+	UpdatedAt             Timestamp `json:"updated_at"`
+}
+
+// UpdateRequest returns an UpdateShortURLRequest prefilled with the current
+// settings, for read-modify-write updates. The password and an existing
+// expiry are left unchanged, so that an expired link can still be updated.
+func (s *ShortURL) UpdateRequest() UpdateShortURLRequest {
+	req := UpdateShortURLRequest{
+		Domain:    s.Domain,
+		Slug:      s.Slug,
+		TargetURL: s.TargetURL,
+		Title:     Set(s.Title),
+	}
+	if s.ExpireAt == 0 {
+		req.ExpireAt = Clear[Expiry]()
+	}
+	if s.ExpirationRedirectURL != "" {
+		req.ExpirationRedirectURL = Set(s.ExpirationRedirectURL)
+	}
+
+	tagIDs := make([]int64, len(s.Tags))
+	for i, tag := range s.Tags {
+		tagIDs[i] = int64(tag.ID)
+	}
+	req.TagIDs = Set(tagIDs)
+	return req
+}
+
+// Text represents a text entry and its current settings.
+type Text struct {
+	Domain      string    `json:"domain"`
+	Slug        string    `json:"slug"`
+	CustomSlug  string    `json:"custom_slug"`
+	ShortURL    string    `json:"short_url"`
+	Title       string    `json:"title"`
+	TextType    string    `json:"text_type"`
+	ExpireAt    Expiry    `json:"expire_at"` // 0 if never
+	HasPassword bool      `json:"has_password"`
+	Tags        []Tag     `json:"tags"`
+	Size        int64     `json:"size"`
+	Visits      int64     `json:"visits"`
+	CreatedAt   Timestamp `json:"created_at"`
+	UpdatedAt   Timestamp `json:"updated_at"`
+}
+
+// File represents an uploaded file.
+type File struct {
+	FileID    int       `json:"file_id"`
+	Filename  string    `json:"filename"`
+	Storename string    `json:"storename"`
+	Size      int       `json:"size"`
+	Width     int       `json:"width"`
+	Height    int       `json:"height"`
+	Hash      string    `json:"hash"`
+	Delete    string    `json:"delete"`
+	Page      string    `json:"page"`
+	Path      string    `json:"path"`
+	URL       string    `json:"url"`
+	CreatedAt Timestamp `json:"created_at"`
+}
+
+// ListPage represents one page of a list response.
+type ListPage[T any] struct {
+	Items    []T `json:"items"`
+	Page     int `json:"page"`
+	PageSize int `json:"page_size"`
+	Total    int `json:"total"`
+}
+
+// ListShortURLsResponse represents the response containing a page of short URLs.
+type ListShortURLsResponse = Response[ListPage[ShortURL]]
+
+// ListTextsResponse represents the response containing a page of texts.
+type ListTextsResponse = Response[ListPage[Text]]
+
+// ListFilesResponse represents the response containing a page of files.
+type ListFilesResponse = Response[ListPage[File]]
+
+// GetShortURLResponse represents the response containing a single short URL.
+type GetShortURLResponse = Response[ShortURL]
+
+// GetTextResponse represents the response containing a single text.
+type GetTextResponse = Response[Text]
+
+// StatsPoint is one bucket of a click time series.
+type StatsPoint struct {
+	Timestamp      Timestamp `json:"timestamp"` // Start of the bucket
+	Clicks         int64     `json:"clicks"`
+	UniqueVisitors int64     `json:"unique_visitors"`
 }
+
+// StatsBreakdown is the number of clicks for one referrer, country, device or browser.
+type StatsBreakdown struct {
+	Key    string `json:"key"`
+	Clicks int64  `json:"clicks"`
+}
+
+// LinkStats contains click statistics for a link or a group of links.
+type LinkStats struct {
+	TotalClicks    int64            `json:"total_clicks"`
+	UniqueVisitors int64            `json:"unique_visitors"`
+	Series         []StatsPoint     `json:"series"`
+	Referrers      []StatsBreakdown `json:"referrers"`
+	Countries      []StatsBreakdown `json:"countries"`
+	Devices        []StatsBreakdown `json:"devices"`
+	Browsers       []StatsBreakdown `json:"browsers"`
+}
+
+// LinkStatsResponse represents the response containing link statistics.
+type LinkStatsResponse = Response[LinkStats]
+
+// AddCustomDomainRequest represents a request to register a custom domain.
+type AddCustomDomainRequest struct {
+	Domain string `json:"domain"`
+}
+
+// SetDomainFallbackRequest represents a request to set the URL that visitors
+// of unknown or expired slugs on a custom domain are redirected to.
+type SetDomainFallbackRequest struct {
+	Domain      string `json:"domain"`
+	FallbackURL string `json:"fallback_url"` // Empty to show the default 404 page
+}
+
+// RemoveCustomDomainRequest represents a request to remove a custom domain.
+type RemoveCustomDomainRequest struct {
+	Domain string `json:"domain"`
+}
+
+// CustomDomainStatus is the verification state of a custom domain.
+type CustomDomainStatus string
+
+const (
+	CustomDomainPending  CustomDomainStatus = "pending"
+	CustomDomainVerified CustomDomainStatus = "verified"
+	CustomDomainFailed   CustomDomainStatus = "failed"
+)
+
+// DNSRecord is a DNS record required to verify a custom domain.
+type DNSRecord struct {
+	Type     string `json:"type"` // TXT, CNAME, A or AAAA
+	Name     string `json:"name"`
+	Value    string `json:"value"`
+	Verified bool   `json:"verified"`
+}
+
+// CustomDomain contains the details of a custom domain.
+type CustomDomain struct {
+	Domain      string             `json:"domain"`
+	Status      CustomDomainStatus `json:"status"`
+	Records     []DNSRecord        `json:"records"`
+	FallbackURL string             `json:"fallback_url"`
+	CreatedAt   Timestamp          `json:"created_at"`
+	VerifiedAt  Timestamp          `json:"verified_at"`
+}
+
+// CustomDomainResponse represents the response containing a custom domain.
+type CustomDomainResponse = Response[CustomDomain]
+
+// DNSRecordsResponse represents the response containing the DNS records of a custom domain.
+type DNSRecordsResponse = Response[DNSRecordList]
+
+// DNSRecordList contains the DNS records of a custom domain.
+type DNSRecordList struct {
+	Records []DNSRecord `json:"records"`
+}
+
+// RemoveCustomDomainResponse represents the response from removing a custom domain.
+type RemoveCustomDomainResponse = Response[any]
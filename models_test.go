@@ -0,0 +1,37 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: models_test.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:36:35
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 04:36:35
+//
+
+package seesdk
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestResponseEnvelope(t *testing.T) {
+	body := []byte(`{"code":200,"message":"success","data":{"slug":"abc","short_url":"https://s.ee/abc"}}`)
+
+	var text CreateTextResponse
+	if err := json.Unmarshal(body, &text); err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	if text.Data.Slug != "abc" || text.Data.ShortURL != "https://s.ee/abc" {
+		t.Errorf("Expected text data to be decoded, got %+v", text.Data)
+	}
+
+	var link CreateShortURLResponse = text
+	if link.Code != 200 || link.Message != "success" {
+		t.Errorf("Expected code 200 and message success, got %d %q", link.Code, link.Message)
+	}
+}
@@ -0,0 +1,111 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: optional.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:29:38
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 04:29:38
+//
+
+package seesdk
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+type optionalState uint8
+
+const (
+	optionalUnset optionalState = iota
+	optionalSet
+	optionalClear
+)
+
+// Optional is a field of an update request with three states: left
+// unchanged (the zero value), set to a value with Set, or cleared with
+// Clear. Unchanged fields are omitted from the request and cleared fields
+// are sent as null.
+type Optional[T any] struct {
+	value T
+	state optionalState
+}
+
+// Set returns an Optional that sets the field to v.
+func Set[T any](v T) Optional[T] {
+	return Optional[T]{value: v, state: optionalSet}
+}
+
+// Clear returns an Optional that clears the field.
+func Clear[T any]() Optional[T] {
+	return Optional[T]{state: optionalClear}
+}
+
+// Get returns the value and whether the field is set to it.
+func (o Optional[T]) Get() (T, bool) {
+	return o.value, o.state == optionalSet
+}
+
+// IsSet reports whether the field is set to a value.
+func (o Optional[T]) IsSet() bool {
+	return o.state == optionalSet
+}
+
+// IsClear reports whether the field is cleared.
+func (o Optional[T]) IsClear() bool {
+	return o.state == optionalClear
+}
+
+// IsUnchanged reports whether the field is left unchanged.
+func (o Optional[T]) IsUnchanged() bool {
+	return o.state == optionalUnset
+}
+
+// MarshalJSON encodes the value, or null if the field is not set.
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if o.state != optionalSet {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON decodes null as Clear and any other value as Set.
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = Clear[T]()
+		return nil
+	}
+
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*o = Set(v)
+	return nil
+}
+
+// optionalField is implemented by every Optional.
+type optionalField interface {
+	IsUnchanged() bool
+}
+
+// patch builds a JSON object for an update request, omitting unchanged
+// optional fields.
+type patch map[string]any
+
+func (p patch) optional(key string, field optionalField) {
+	if !field.IsUnchanged() {
+		p[key] = field
+	}
+}
+
+func (p patch) nonEmpty(key, value string) {
+	if value != "" {
+		p[key] = value
+	}
+}
@@ -0,0 +1,94 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: optional_test.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:29:52
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 04:31:19
+//
+
+package seesdk
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestUpdateShortURLRequestJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		req  UpdateShortURLRequest
+		want string
+	}{
+		{
+			name: "unchanged fields are omitted",
+			req:  UpdateShortURLRequest{Domain: "s.ee", Slug: "abc", TargetURL: "https://example.com"},
+			want: `{"domain":"s.ee","slug":"abc","target_url":"https://example.com"}`,
+		},
+		{
+			name: "set and clear",
+			req: UpdateShortURLRequest{
+				Domain:   "s.ee",
+				Slug:     "abc",
+				Title:    Set(""),
+				ExpireAt: Set(Expiry(1700000000)),
+				Password: Clear[string](),
+				TagIDs:   Set([]int64{1, 2}),
+			},
+			want: `{"domain":"s.ee","expire_at":1700000000,"password":null,"slug":"abc","tag_ids":[1,2],"title":""}`,
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := json.Marshal(tt.req)
+		if err != nil {
+			t.Fatalf("%s: expected no error, got: %v", tt.name, err)
+		}
+		if string(got) != tt.want {
+			t.Errorf("%s: expected %s, got %s", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestUpdateTextRequestJSON(t *testing.T) {
+	got, err := json.Marshal(UpdateTextRequest{
+		Domain:   "ba.sh",
+		Slug:     "abc",
+		TextType: Set("go"),
+		ExpireAt: Clear[Expiry](),
+	})
+	if err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+
+	want := `{"domain":"ba.sh","expire_at":null,"slug":"abc","text_type":"go"}`
+	if string(got) != want {
+		t.Errorf("Expected %s, got %s", want, got)
+	}
+}
+
+func TestOptionalUnmarshal(t *testing.T) {
+	var v struct {
+		A Optional[string] `json:"a"`
+		B Optional[string] `json:"b"`
+		C Optional[string] `json:"c"`
+	}
+	if err := json.Unmarshal([]byte(`{"a":"x","b":null}`), &v); err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+
+	if a, ok := v.A.Get(); !ok || a != "x" {
+		t.Errorf("Expected a to be set to x, got %q (%v)", a, ok)
+	}
+	if !v.B.IsClear() {
+		t.Error("Expected b to be cleared")
+	}
+	if !v.C.IsUnchanged() {
+		t.Error("Expected c to be unchanged")
+	}
+}
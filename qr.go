@@ -0,0 +1,201 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: qr.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:28:50
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 04:28:50
+//
+
+package seesdk
+
+import (
+	"context"
+	"fmt"
+	"image/color"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/sdotee/sdk.go/qrcode"
+)
+
+// QRFormat is the image format of a QR code.
+type QRFormat string
+
+const (
+	QRFormatPNG QRFormat = "png"
+	QRFormatSVG QRFormat = "svg"
+)
+
+// QRLevel is the error correction level of a QR code: "L", "M", "Q" or "H".
+type QRLevel string
+
+const (
+	QRLevelLow      QRLevel = "L"
+	QRLevelMedium   QRLevel = "M"
+	QRLevelQuartile QRLevel = "Q"
+	QRLevelHigh     QRLevel = "H"
+)
+
+func (l QRLevel) level() (qrcode.Level, error) {
+	switch l {
+	case QRLevelLow:
+		return qrcode.Low, nil
+	case QRLevelMedium:
+		return qrcode.Medium, nil
+	case QRLevelQuartile:
+		return qrcode.Quartile, nil
+	case QRLevelHigh:
+		return qrcode.High, nil
+	default:
+		return 0, fmt.Errorf("unsupported QR code level %q", l)
+	}
+}
+
+// DefaultQRSize is the default width and height of a QR code in pixels.
+const DefaultQRSize = 256
+
+// QROptions contains options for rendering a QR code. Zero values use the
+// defaults: 256px PNG, 4 module margin, black on white, medium error correction.
+type QROptions struct {
+	Size       int      // Width and height in pixels
+	Format     QRFormat // QRFormatPNG or QRFormatSVG
+	Margin     int      // Quiet zone in modules; use a negative value for none
+	Foreground string   // Hex color such as "#000000"
+	Background string   // Hex color such as "#ffffff"
+	Level      QRLevel  // Error correction level
+}
+
+func (o QROptions) withDefaults() QROptions {
+	if o.Size <= 0 {
+		o.Size = DefaultQRSize
+	}
+	if o.Format == "" {
+		o.Format = QRFormatPNG
+	}
+	if o.Margin == 0 {
+		o.Margin = 4
+	} else if o.Margin < 0 {
+		o.Margin = 0
+	}
+	if o.Foreground == "" {
+		o.Foreground = "#000000"
+	}
+	if o.Background == "" {
+		o.Background = "#ffffff"
+	}
+	if o.Level == "" {
+		o.Level = QRLevelMedium
+	}
+	return o
+}
+
+// QRCode is a rendered QR code image.
+type QRCode struct {
+	ContentType string
+	Data        []byte
+}
+
+// GetQRCode renders the QR code of a short URL on the server. Each call
+// counts towards the QR code quota; see RenderQRCode for offline rendering.
+func (c *Client) GetQRCode(ctx context.Context, domain, slug string, opts QROptions) (*QRCode, error) {
+	opts = opts.withDefaults()
+	contentType, err := opts.Format.contentType()
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("domain", domain)
+	query.Set("slug", slug)
+	query.Set("size", strconv.Itoa(opts.Size))
+	query.Set("format", string(opts.Format))
+	query.Set("margin", strconv.Itoa(opts.Margin))
+	query.Set("foreground", opts.Foreground)
+	query.Set("background", opts.Background)
+	query.Set("level", string(opts.Level))
+
+	respBody, err := c.doRequestContext(ctx, "GET", "/qrcode?"+query.Encode(), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QRCode{ContentType: contentType, Data: respBody}, nil
+}
+
+// RenderQRCode renders a QR code for content, such as the ShortURL of a
+// CreateShortURLResponse, locally without calling the API or using quota.
+// PNG output uses whole pixels per module, so the image may be slightly
+// smaller than opts.Size.
+func RenderQRCode(content string, opts QROptions) (*QRCode, error) {
+	opts = opts.withDefaults()
+	contentType, err := opts.Format.contentType()
+	if err != nil {
+		return nil, err
+	}
+
+	fg, err := parseHexColor(opts.Foreground)
+	if err != nil {
+		return nil, err
+	}
+	bg, err := parseHexColor(opts.Background)
+	if err != nil {
+		return nil, err
+	}
+
+	level, err := opts.Level.level()
+	if err != nil {
+		return nil, err
+	}
+
+	code, err := qrcode.Encode(content, level)
+	if err != nil {
+		return nil, fmt.Errorf("encode QR code: %w", err)
+	}
+
+	var data []byte
+	switch opts.Format {
+	case QRFormatSVG:
+		data = code.SVG(opts.Size, opts.Margin, fg, bg)
+	default:
+		scale := max(1, opts.Size/(code.Size+2*opts.Margin))
+		if data, err = code.PNG(scale, opts.Margin, fg, bg); err != nil {
+			return nil, err
+		}
+	}
+
+	return &QRCode{ContentType: contentType, Data: data}, nil
+}
+
+func (f QRFormat) contentType() (string, error) {
+	switch f {
+	case QRFormatPNG:
+		return "image/png", nil
+	case QRFormatSVG:
+		return "image/svg+xml", nil
+	default:
+		return "", fmt.Errorf("unsupported QR code format %q", f)
+	}
+}
+
+// parseHexColor parses colors of the form "#rgb" or "#rrggbb".
+func parseHexColor(s string) (color.Color, error) {
+	hex := strings.TrimPrefix(s, "#")
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	if len(hex) != 6 {
+		return nil, fmt.Errorf("invalid color %q", s)
+	}
+	v, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid color %q", s)
+	}
+	return color.RGBA{R: uint8(v >> 16), G: uint8(v >> 8), B: uint8(v), A: 0xFF}, nil
+}
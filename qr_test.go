@@ -0,0 +1,85 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: qr_test.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 05:00:32
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 05:00:32
+//
+
+package seesdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestGetQRCode(t *testing.T) {
+	var query url.Values
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/qrcode" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		query = r.URL.Query()
+		w.Write([]byte("image"))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{BaseURL: server.URL})
+
+	qr, err := client.GetQRCode(context.Background(), "s.ee", "abc", QROptions{})
+	if err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	if qr.ContentType != "image/png" || string(qr.Data) != "image" {
+		t.Errorf("Expected PNG image data, got %s %q", qr.ContentType, qr.Data)
+	}
+	want := url.Values{
+		"domain":     {"s.ee"},
+		"slug":       {"abc"},
+		"size":       {"256"},
+		"format":     {"png"},
+		"margin":     {"4"},
+		"foreground": {"#000000"},
+		"background": {"#ffffff"},
+		"level":      {"M"},
+	}
+	if query.Encode() != want.Encode() {
+		t.Errorf("Expected default query %s, got %s", want.Encode(), query.Encode())
+	}
+
+	qr, err = client.GetQRCode(context.Background(), "s.ee", "abc", QROptions{
+		Size:       512,
+		Format:     QRFormatSVG,
+		Margin:     -1,
+		Foreground: "#1a73e8",
+		Level:      QRLevelHigh,
+	})
+	if err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	if qr.ContentType != "image/svg+xml" {
+		t.Errorf("Expected SVG content type, got %s", qr.ContentType)
+	}
+	if query.Get("size") != "512" || query.Get("margin") != "0" ||
+		query.Get("foreground") != "#1a73e8" || query.Get("level") != "H" {
+		t.Errorf("Expected options to be forwarded, got %s", query.Encode())
+	}
+
+	query = nil
+	if _, err := client.GetQRCode(context.Background(), "s.ee", "abc", QROptions{Format: "gif"}); err == nil {
+		t.Error("Expected error for unsupported format")
+	}
+	if query != nil {
+		t.Error("Expected no request for an unsupported format")
+	}
+}
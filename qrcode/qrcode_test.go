@@ -0,0 +1,262 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: qrcode_test.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:28:28
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 04:28:28
+//
+
+package qrcode
+
+import (
+	"bytes"
+	"image/color"
+	"image/png"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFormatInfo(t *testing.T) {
+	tests := []struct {
+		level Level
+		mask  int
+		want  int
+	}{
+		{Low, 0, 0x77C4},
+		{Medium, 0, 0x5412},
+		{Quartile, 0, 0x355F},
+		{High, 0, 0x1689},
+	}
+
+	for _, tt := range tests {
+		if got := formatInfo(tt.level, tt.mask); got != tt.want {
+			t.Errorf("formatInfo(%s, %d) = %#x, want %#x", tt.level, tt.mask, got, tt.want)
+		}
+	}
+
+	if got := versionInfo(7); got != 0x07C94 {
+		t.Errorf("versionInfo(7) = %#x, want 0x7c94", got)
+	}
+}
+
+func TestReedSolomon(t *testing.T) {
+	// Version 1-M encoding of "HELLO WORLD" from the QR specification.
+	data := []byte{32, 91, 11, 120, 209, 114, 220, 77, 67, 64, 236, 17, 236, 17, 236, 17}
+	want := []byte{196, 35, 39, 119, 235, 215, 231, 226, 93, 23}
+
+	if got := rsRemainder(data, rsDivisor(10)); !bytes.Equal(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestCapacity(t *testing.T) {
+	tests := []struct {
+		version int
+		level   Level
+		want    int
+	}{
+		{1, Low, 19}, {1, Medium, 16}, {1, Quartile, 13}, {1, High, 9},
+		{10, Low, 274}, {10, Medium, 216}, {10, Quartile, 154}, {10, High, 122},
+		{40, Low, 2956}, {40, Medium, 2334}, {40, Quartile, 1666}, {40, High, 1276},
+	}
+
+	for _, tt := range tests {
+		if got := numDataCodewords(tt.version, tt.level); got != tt.want {
+			t.Errorf("numDataCodewords(%d, %s) = %d, want %d", tt.version, tt.level, got, tt.want)
+		}
+	}
+}
+
+func TestAlignmentPositions(t *testing.T) {
+	tests := map[int][]int{
+		1:  nil,
+		2:  {6, 18},
+		7:  {6, 22, 38},
+		15: {6, 26, 48, 70},
+		32: {6, 34, 60, 86, 112, 138},
+		40: {6, 30, 58, 86, 114, 142, 170},
+	}
+
+	for version, want := range tests {
+		if got := alignmentPositions(version); !reflect.DeepEqual(got, want) {
+			t.Errorf("alignmentPositions(%d) = %v, want %v", version, got, want)
+		}
+	}
+}
+
+// decode reads a symbol back by reversing the encoding steps, verifying
+// the format information and error correction along the way.
+func decode(t *testing.T, c *Code) string {
+	t.Helper()
+
+	// Both copies of the format information must match.
+	first, second := 0, 0
+	for i := 0; i <= 5; i++ {
+		first |= b2i(c.modules[i][8]) << i
+	}
+	first |= b2i(c.modules[7][8])<<6 | b2i(c.modules[8][8])<<7 | b2i(c.modules[8][7])<<8
+	for i := 9; i < 15; i++ {
+		first |= b2i(c.modules[8][14-i]) << i
+	}
+	for i := 0; i < 8; i++ {
+		second |= b2i(c.modules[8][c.Size-1-i]) << i
+	}
+	for i := 8; i < 15; i++ {
+		second |= b2i(c.modules[c.Size-15+i][8]) << i
+	}
+	if first != second || first != formatInfo(c.Level, c.Mask) {
+		t.Fatalf("format information mismatch: %#x, %#x", first, second)
+	}
+
+	// Unmask a copy and collect the codewords in placement order.
+	ref := newCode(c.Version, c.Level)
+	ref.drawFunctionPatterns()
+	for y := range ref.modules {
+		copy(ref.modules[y], c.modules[y])
+	}
+	ref.applyMask(c.Mask)
+
+	var raw []byte
+	var cur, n int
+	for right := c.Size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		for vert := 0; vert < c.Size; vert++ {
+			y := vert
+			if (right+1)&2 == 0 {
+				y = c.Size - 1 - vert
+			}
+			for j := 0; j < 2; j++ {
+				if ref.isFunction[y][right-j] {
+					continue
+				}
+				cur = cur<<1 | b2i(ref.modules[y][right-j])
+				if n++; n%8 == 0 {
+					raw = append(raw, byte(cur))
+					cur = 0
+				}
+			}
+		}
+	}
+
+	// De-interleave and check each block's error correction.
+	numBlocks := numECBlocks[c.Level][c.Version]
+	eccLen := eccCodewordsPerBlock[c.Level][c.Version]
+	rawCodewords := numRawDataModules(c.Version) / 8
+	numShort := numBlocks - rawCodewords%numBlocks
+	shortLen := rawCodewords / numBlocks
+
+	blocks := make([][]byte, numBlocks)
+	k := 0
+	for i := 0; i < shortLen+1; i++ {
+		for j := range blocks {
+			if i == shortLen-eccLen && j < numShort {
+				continue
+			}
+			blocks[j] = append(blocks[j], raw[k])
+			k++
+		}
+	}
+
+	var data []byte
+	for i, block := range blocks {
+		dataLen := len(block) - eccLen
+		if !bytes.Equal(rsRemainder(block[:dataLen], rsDivisor(eccLen)), block[dataLen:]) {
+			t.Fatalf("block %d: error correction mismatch", i)
+		}
+		data = append(data, block[:dataLen]...)
+	}
+
+	// Parse the byte mode segment.
+	bits := &bitReader{data: data}
+	if mode := bits.read(4); mode != 0x4 {
+		t.Fatalf("Expected byte mode, got %#x", mode)
+	}
+	length := bits.read(charCountBits(c.Version))
+	out := make([]byte, length)
+	for i := range out {
+		out[i] = byte(bits.read(8))
+	}
+	return string(out)
+}
+
+type bitReader struct {
+	data []byte
+	pos  int
+}
+
+func (r *bitReader) read(n int) int {
+	v := 0
+	for i := 0; i < n; i++ {
+		v = v<<1 | int(r.data[r.pos>>3]>>(7-r.pos&7)&1)
+		r.pos++
+	}
+	return v
+}
+
+func b2i(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func TestEncodeRoundTrip(t *testing.T) {
+	inputs := []string{
+		"https://s.ee/abc",
+		"",
+		strings.Repeat("https://example.com/very/long/url?q=", 20),
+		strings.Repeat("x", 1200),
+	}
+
+	for _, level := range []Level{Low, Medium, Quartile, High} {
+		for _, input := range inputs {
+			c, err := Encode(input, level)
+			if err != nil {
+				t.Fatalf("Encode(%d bytes, %s): %v", len(input), level, err)
+			}
+			if c.Size != c.Version*4+17 {
+				t.Errorf("Expected size %d, got %d", c.Version*4+17, c.Size)
+			}
+			if got := decode(t, c); got != input {
+				t.Errorf("level %s: round trip mismatch for %d bytes", level, len(input))
+			}
+		}
+	}
+
+	if _, err := Encode(strings.Repeat("x", 3000), Low); err == nil {
+		t.Error("Expected error for data too long")
+	}
+}
+
+func TestRender(t *testing.T) {
+	c, err := Encode("https://s.ee/abc", Medium)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := c.PNG(4, 4, color.Black, color.White)
+	if err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal("Expected valid png, got:", err)
+	}
+	if side := (c.Size + 8) * 4; img.Bounds().Dx() != side {
+		t.Errorf("Expected %d pixels wide, got %d", side, img.Bounds().Dx())
+	}
+
+	svg := string(c.SVG(256, 4, color.Black, color.White))
+	if !strings.Contains(svg, `width="256"`) || !strings.Contains(svg, `fill="#000000"`) {
+		t.Errorf("Unexpected SVG output: %.200s", svg)
+	}
+}
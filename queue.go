@@ -0,0 +1,523 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: queue.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:40:36
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 05:16:01
+//
+
+package seesdk
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultQueueRetryDelay is the default delay before retrying after the
+	// API could not be reached. It doubles with every failed attempt.
+	DefaultQueueRetryDelay = time.Second
+	// DefaultQueueMaxRetryDelay is the default upper bound of the retry delay.
+	DefaultQueueMaxRetryDelay = 5 * time.Minute
+
+	// queueCompactThreshold is the number of finished jobs after which the
+	// journal is compacted.
+	queueCompactThreshold = 256
+
+	queueJournalFile = "journal.jsonl"
+	queueSpoolDir    = "spool"
+)
+
+// QueueJobKind is the type of operation of a queued job.
+type QueueJobKind string
+
+const (
+	QueueShortURL QueueJobKind = "short_url"
+	QueueText     QueueJobKind = "text"
+	QueueUpload   QueueJobKind = "upload"
+)
+
+// QueueJob is a create operation waiting in a Queue.
+type QueueJob struct {
+	ID        string                 `json:"id"`
+	Kind      QueueJobKind           `json:"kind"`
+	CreatedAt time.Time              `json:"created_at"`
+	Attempts  int                    `json:"attempts"`
+	ShortURL  *CreateShortURLRequest `json:"short_url,omitempty"`
+	Text      *CreateTextRequest     `json:"text,omitempty"`
+	TagNames  []string               `json:"tag_names,omitempty"` // Tag names of ShortURL or Text
+	Filename  string                 `json:"filename,omitempty"`  // Name of the file to upload
+}
+
+// QueueResult is the outcome of a queued job. Exactly one of ShortURL, Text
+// and File is set unless Err is.
+type QueueResult struct {
+	Job      QueueJob
+	ShortURL *CreateShortURLResponse
+	Text     *CreateTextResponse
+	File     *UploadFileResponse
+	Err      error // Permanent failure; the job is not retried
+}
+
+// QueueOptions contains options for a Queue.
+type QueueOptions struct {
+	// OnResult is called when a job completes or fails permanently.
+	OnResult func(QueueResult)
+	// RetryDelay is the initial delay between retries (default: DefaultQueueRetryDelay).
+	RetryDelay time.Duration
+	// MaxRetryDelay caps the retry delay (default: DefaultQueueMaxRetryDelay).
+	MaxRetryDelay time.Duration
+	// MaxAttempts fails a job permanently after this many attempts.
+	// Zero retries forever.
+	MaxAttempts int
+}
+
+// journalEntry is one line of the queue journal.
+type journalEntry struct {
+	Op  string    `json:"op"` // "enqueue", "attempt" or "done"
+	Job *QueueJob `json:"job,omitempty"`
+	ID  string    `json:"id,omitempty"`
+}
+
+// Queue stores create operations in an append-only journal on disk and
+// sends them through the client when the API is reachable. Pending jobs
+// survive restarts. Short URLs and texts are sent with the job ID as their
+// idempotency key, so a job that is sent again after a crash is not
+// duplicated by servers that honor the key. Jobs are sent in order;
+// network errors, 5xx and 429 responses stop the drain and are retried
+// later, while other errors fail the job permanently.
+//
+// Uploads have no idempotency key. If the process stops after an upload
+// succeeded but before the job was marked done, the file is uploaded again
+// when the queue is reopened.
+type Queue struct {
+	client *Client
+	dir    string
+	opts   QueueOptions
+	notify chan struct{}
+
+	drainMu sync.Mutex
+
+	mu       sync.Mutex
+	journal  *os.File
+	pending  []*QueueJob
+	finished int
+}
+
+// OpenQueue opens the queue stored in dir, creating it if needed, and
+// replays its journal.
+func OpenQueue(client *Client, dir string, opts QueueOptions) (*Queue, error) {
+	if opts.RetryDelay <= 0 {
+		opts.RetryDelay = DefaultQueueRetryDelay
+	}
+	if opts.MaxRetryDelay <= 0 {
+		opts.MaxRetryDelay = DefaultQueueMaxRetryDelay
+	}
+	if err := os.MkdirAll(filepath.Join(dir, queueSpoolDir), 0o755); err != nil {
+		return nil, fmt.Errorf("create queue directory: %w", err)
+	}
+
+	q := &Queue{client: client, dir: dir, opts: opts, notify: make(chan struct{}, 1)}
+	if err := q.replay(); err != nil {
+		return nil, err
+	}
+
+	journal, err := os.OpenFile(q.journalPath(), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("open queue journal: %w", err)
+	}
+	q.journal = journal
+	return q, nil
+}
+
+// Close closes the journal. Pending jobs remain on disk.
+func (q *Queue) Close() error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.journal.Close()
+}
+
+// EnqueueShortURL adds a short URL creation to the queue and returns the job ID.
+func (q *Queue) EnqueueShortURL(req CreateShortURLRequest) (string, error) {
+	if err := req.Validate(); err != nil {
+		return "", err
+	}
+	return q.enqueue(&QueueJob{Kind: QueueShortURL, ShortURL: &req, TagNames: req.TagNames})
+}
+
+// EnqueueText adds a text creation to the queue and returns the job ID.
+func (q *Queue) EnqueueText(req CreateTextRequest) (string, error) {
+	if err := req.Validate(); err != nil {
+		return "", err
+	}
+	return q.enqueue(&QueueJob{Kind: QueueText, Text: &req, TagNames: req.TagNames})
+}
+
+// EnqueueUpload copies r to the spool directory and adds its upload to the
+// queue. It returns the job ID.
+func (q *Queue) EnqueueUpload(filename string, r io.Reader) (string, error) {
+	id, err := newJobID()
+	if err != nil {
+		return "", err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Join(q.dir, queueSpoolDir), id+".*")
+	if err != nil {
+		return "", fmt.Errorf("create spool file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	n, err := io.Copy(tmp, io.LimitReader(r, maxUploadSize+1))
+	if err == nil && n > maxUploadSize {
+		err = fmt.Errorf("file size exceeds the limit of %d bytes", maxUploadSize)
+	}
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", fmt.Errorf("spool file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), q.spoolPath(id)); err != nil {
+		return "", fmt.Errorf("spool file: %w", err)
+	}
+
+	return q.enqueue(&QueueJob{ID: id, Kind: QueueUpload, Filename: filename})
+}
+
+// Pending returns the jobs waiting to be sent, oldest first.
+func (q *Queue) Pending() []QueueJob {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	jobs := make([]QueueJob, len(q.pending))
+	for i, job := range q.pending {
+		jobs[i] = *job
+	}
+	return jobs
+}
+
+// Drain sends pending jobs in order until the queue is empty, ctx is done,
+// or a job fails with a retryable error, which is returned. Cancelling ctx
+// also aborts the request in flight; its job stays pending.
+func (q *Queue) Drain(ctx context.Context) error {
+	q.drainMu.Lock()
+	defer q.drainMu.Unlock()
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		q.mu.Lock()
+		if len(q.pending) == 0 {
+			q.mu.Unlock()
+			break
+		}
+		job := q.pending[0]
+		job.Attempts++
+		err := q.appendLocked(journalEntry{Op: "attempt", ID: job.ID})
+		snapshot := *job
+		q.mu.Unlock()
+		if err != nil {
+			return err
+		}
+
+		result, err := q.process(ctx, snapshot)
+		if err != nil && ctx.Err() != nil {
+			// Interrupted; the job stays pending.
+			return ctx.Err()
+		}
+		if err != nil && isRetryable(err) && (q.opts.MaxAttempts == 0 || snapshot.Attempts < q.opts.MaxAttempts) {
+			return err
+		}
+		result.Err = err
+
+		if err := q.finish(job); err != nil {
+			return err
+		}
+		if q.opts.OnResult != nil {
+			q.opts.OnResult(result)
+		}
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.finished >= queueCompactThreshold {
+		return q.compactLocked()
+	}
+	return nil
+}
+
+// Run drains the queue whenever jobs are added, retrying with exponential
+// backoff while the API is unreachable, until ctx is done. It returns ctx.Err().
+func (q *Queue) Run(ctx context.Context) error {
+	failures := 0
+	for {
+		if err := q.Drain(ctx); err != nil && ctx.Err() == nil {
+			delay := q.opts.RetryDelay << min(failures, 30)
+			if delay <= 0 || delay > q.opts.MaxRetryDelay {
+				delay = q.opts.MaxRetryDelay
+			}
+			failures++
+
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(delay):
+			}
+			continue
+		}
+		failures = 0
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-q.notify:
+		}
+	}
+}
+
+// Compact rewrites the journal so that it only contains pending jobs.
+func (q *Queue) Compact() error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.compactLocked()
+}
+
+func (q *Queue) process(ctx context.Context, job QueueJob) (QueueResult, error) {
+	result := QueueResult{Job: job}
+	var err error
+	switch job.Kind {
+	case QueueShortURL:
+		req := *job.ShortURL
+		req.TagNames = job.TagNames
+		req.IdempotencyKey = job.ID
+		result.ShortURL, err = q.client.createShortURLContext(ctx, req)
+	case QueueText:
+		req := *job.Text
+		req.TagNames = job.TagNames
+		req.IdempotencyKey = job.ID
+		result.Text, err = q.client.createTextContext(ctx, req)
+	case QueueUpload:
+		var f *os.File
+		if f, err = os.Open(q.spoolPath(job.ID)); err != nil {
+			return result, fmt.Errorf("open spool file: %w", err)
+		}
+		defer f.Close()
+		result.File, err = q.client.uploadFile(ctx, job.Filename, f)
+	default:
+		err = fmt.Errorf("unknown job kind %q", job.Kind)
+	}
+	return result, err
+}
+
+func (q *Queue) enqueue(job *QueueJob) (string, error) {
+	if job.ID == "" {
+		id, err := newJobID()
+		if err != nil {
+			return "", err
+		}
+		job.ID = id
+	}
+	job.CreatedAt = time.Now()
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if err := q.appendLocked(journalEntry{Op: "enqueue", Job: job}); err != nil {
+		return "", err
+	}
+	q.pending = append(q.pending, job)
+
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+	return job.ID, nil
+}
+
+func (q *Queue) finish(job *QueueJob) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if err := q.appendLocked(journalEntry{Op: "done", ID: job.ID}); err != nil {
+		return err
+	}
+	for i, pending := range q.pending {
+		if pending == job {
+			q.pending = append(q.pending[:i], q.pending[i+1:]...)
+			break
+		}
+	}
+	q.finished++
+	if job.Kind == QueueUpload {
+		os.Remove(q.spoolPath(job.ID))
+	}
+	return nil
+}
+
+// appendLocked writes an entry to the journal and syncs it to disk.
+func (q *Queue) appendLocked(entry journalEntry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("marshal journal entry: %w", err)
+	}
+	if _, err := q.journal.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("write queue journal: %w", err)
+	}
+	if err := q.journal.Sync(); err != nil {
+		return fmt.Errorf("sync queue journal: %w", err)
+	}
+	return nil
+}
+
+// replay rebuilds the pending jobs from the journal. A partially written
+// last line is dropped.
+func (q *Queue) replay() error {
+	data, err := os.ReadFile(q.journalPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("read queue journal: %w", err)
+	}
+
+	jobs := map[string]*QueueJob{}
+	// good is the length of the journal up to the last complete entry.
+	good := 0
+	for line := 1; good < len(data); line++ {
+		end := bytes.IndexByte(data[good:], '\n')
+		if end < 0 {
+			break // torn write
+		}
+		raw := bytes.TrimSpace(data[good : good+end])
+		next := good + end + 1
+		if len(raw) == 0 {
+			good = next
+			continue
+		}
+		var entry journalEntry
+		if err := json.Unmarshal(raw, &entry); err != nil {
+			if next == len(data) {
+				break
+			}
+			return fmt.Errorf("parse queue journal line %d: %w", line, err)
+		}
+		good = next
+
+		switch entry.Op {
+		case "enqueue":
+			if entry.Job != nil {
+				jobs[entry.Job.ID] = entry.Job
+				q.pending = append(q.pending, entry.Job)
+			}
+		case "attempt":
+			if job, ok := jobs[entry.ID]; ok {
+				job.Attempts++
+			}
+		case "done":
+			if job, ok := jobs[entry.ID]; ok {
+				delete(jobs, entry.ID)
+				for i, pending := range q.pending {
+					if pending == job {
+						q.pending = append(q.pending[:i], q.pending[i+1:]...)
+						break
+					}
+				}
+				q.finished++
+			}
+		}
+	}
+
+	// Drop a torn last line so that new entries start on a new line.
+	if good < len(data) {
+		if err := os.Truncate(q.journalPath(), int64(good)); err != nil {
+			return fmt.Errorf("truncate queue journal: %w", err)
+		}
+	}
+	return nil
+}
+
+func (q *Queue) compactLocked() error {
+	tmp, err := os.CreateTemp(q.dir, queueJournalFile+".*")
+	if err != nil {
+		return fmt.Errorf("create queue journal: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	enc := json.NewEncoder(tmp)
+	for _, job := range q.pending {
+		if err = enc.Encode(journalEntry{Op: "enqueue", Job: job}); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = tmp.Chmod(0o644)
+	}
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("write queue journal: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), q.journalPath()); err != nil {
+		return fmt.Errorf("replace queue journal: %w", err)
+	}
+	journal, err := os.OpenFile(q.journalPath(), os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("open queue journal: %w", err)
+	}
+	q.journal.Close()
+	q.journal = journal
+	q.finished = 0
+	return nil
+}
+
+func (q *Queue) journalPath() string {
+	return filepath.Join(q.dir, queueJournalFile)
+}
+
+func (q *Queue) spoolPath(id string) string {
+	return filepath.Join(q.dir, queueSpoolDir, id)
+}
+
+// isRetryable reports whether err is a network error, a server error or a
+// rate limit response.
+func isRetryable(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode >= 500 || apiErr.StatusCode == 429
+	}
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
+
+func newJobID() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", fmt.Errorf("generate job ID: %w", err)
+	}
+	return hex.EncodeToString(b[:]), nil
+}
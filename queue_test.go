@@ -0,0 +1,241 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: queue_test.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:40:57
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 05:16:01
+//
+
+package seesdk
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestQueueDrain(t *testing.T) {
+	var mu sync.Mutex
+	status := http.StatusServiceUnavailable
+	var received []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			return
+		}
+		switch r.URL.Path {
+		case "/shorten":
+			received = append(received, "shorten")
+			w.Write([]byte(`{"code":200,"data":{"slug":"abc"}}`))
+		case "/file/upload":
+			r.ParseMultipartForm(1 << 20)
+			file, _, _ := r.FormFile("file")
+			data, _ := io.ReadAll(file)
+			received = append(received, "upload:"+string(data))
+			w.Write([]byte(`{"code":200,"data":{"url":"https://s.ee/f"}}`))
+		}
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	client := NewClient(Config{BaseURL: server.URL})
+	q, err := OpenQueue(client, dir, QueueOptions{})
+	if err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+
+	if _, err := q.EnqueueShortURL(CreateShortURLRequest{Domain: "s.ee", TargetURL: "https://example.com"}); err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	if _, err := q.EnqueueUpload("a.txt", strings.NewReader("hello")); err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	if _, err := q.EnqueueShortURL(CreateShortURLRequest{TargetURL: "mailto:x"}); err == nil {
+		t.Error("Expected invalid request to be rejected")
+	}
+
+	var apiErr *APIError
+	if err := q.Drain(context.Background()); !errors.As(err, &apiErr) {
+		t.Fatalf("Expected retryable API error, got: %v", err)
+	}
+	q.Close()
+
+	// Reopen as after a restart; both jobs are still pending.
+	var results []QueueResult
+	q, err = OpenQueue(client, dir, QueueOptions{OnResult: func(r QueueResult) { results = append(results, r) }})
+	if err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	defer q.Close()
+	pending := q.Pending()
+	if len(pending) != 2 || pending[0].Attempts != 1 || pending[1].Kind != QueueUpload {
+		t.Fatalf("Expected 2 pending jobs after replay, got %+v", pending)
+	}
+
+	mu.Lock()
+	status = http.StatusOK
+	mu.Unlock()
+	if err := q.Drain(context.Background()); err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+
+	if len(results) != 2 || results[0].ShortURL == nil || results[1].File == nil {
+		t.Fatalf("Expected 2 results, got %+v", results)
+	}
+	if strings.Join(received, ",") != "shorten,upload:hello" {
+		t.Errorf("Unexpected requests %v", received)
+	}
+	if len(q.Pending()) != 0 {
+		t.Error("Expected no pending jobs")
+	}
+	if entries, _ := os.ReadDir(filepath.Join(dir, queueSpoolDir)); len(entries) != 0 {
+		t.Errorf("Expected spool to be empty, got %d files", len(entries))
+	}
+
+	if err := q.Compact(); err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	if data, _ := os.ReadFile(filepath.Join(dir, queueJournalFile)); len(data) != 0 {
+		t.Errorf("Expected empty journal after compaction, got %q", data)
+	}
+}
+
+func TestQueueTornJournal(t *testing.T) {
+	dir := t.TempDir()
+	client := NewClient(Config{BaseURL: "http://127.0.0.1:0"})
+	enqueue := func(q *Queue) {
+		if _, err := q.EnqueueText(CreateTextRequest{Content: "hello"}); err != nil {
+			t.Fatal("Expected no error, got:", err)
+		}
+	}
+
+	q, err := OpenQueue(client, dir, QueueOptions{})
+	if err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	enqueue(q)
+	q.Close()
+
+	// Simulate a crash in the middle of a journal write.
+	f, _ := os.OpenFile(filepath.Join(dir, queueJournalFile), os.O_APPEND|os.O_WRONLY, 0)
+	f.WriteString(`{"op":"enqueue","job":{"id":"`)
+	f.Close()
+
+	q, err = OpenQueue(client, dir, QueueOptions{})
+	if err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	enqueue(q)
+	enqueue(q)
+	q.Close()
+
+	q, err = OpenQueue(client, dir, QueueOptions{})
+	if err != nil {
+		t.Fatal("Expected no error after reopening, got:", err)
+	}
+	defer q.Close()
+	if got := len(q.Pending()); got != 3 {
+		t.Errorf("Expected 3 pending jobs, got %d", got)
+	}
+}
+
+func TestQueueDrainCancel(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	q, err := OpenQueue(NewClient(Config{BaseURL: server.URL}), t.TempDir(), QueueOptions{})
+	if err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	defer q.Close()
+	q.EnqueueText(CreateTextRequest{Domain: "s.ee", Content: "hello"})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if err := q.Drain(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context deadline error, got: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Expected the in-flight request to be cancelled, took %s", elapsed)
+	}
+	if len(q.Pending()) != 1 {
+		t.Error("Expected the interrupted job to stay pending")
+	}
+}
+
+func TestQueuePermanentFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	var result QueueResult
+	q, err := OpenQueue(NewClient(Config{BaseURL: server.URL}), t.TempDir(), QueueOptions{
+		OnResult: func(r QueueResult) { result = r },
+	})
+	if err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	defer q.Close()
+
+	q.EnqueueText(CreateTextRequest{Content: "hello"})
+	if err := q.Drain(context.Background()); err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	if result.Err == nil || len(q.Pending()) != 0 {
+		t.Errorf("Expected job to fail permanently, got %+v", result)
+	}
+}
+
+func TestQueueRun(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"code":200,"data":{"slug":"abc"}}`))
+	}))
+	defer server.Close()
+
+	done := make(chan QueueResult, 1)
+	q, err := OpenQueue(NewClient(Config{BaseURL: server.URL}), t.TempDir(), QueueOptions{
+		OnResult: func(r QueueResult) { done <- r },
+	})
+	if err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	defer q.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go q.Run(ctx)
+
+	q.EnqueueShortURL(CreateShortURLRequest{Domain: "s.ee", TargetURL: "https://example.com"})
+	select {
+	case r := <-done:
+		if r.Err != nil || r.ShortURL.Data.Slug != "abc" {
+			t.Errorf("Unexpected result %+v", r)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for job")
+	}
+}
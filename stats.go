@@ -0,0 +1,185 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: stats.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:25:33
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 04:31:19
+//
+
+package seesdk
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// StatsGranularity is the bucket size of a statistics time series.
+type StatsGranularity string
+
+const (
+	GranularityHour  StatsGranularity = "hour"
+	GranularityDay   StatsGranularity = "day"
+	GranularityWeek  StatsGranularity = "week"
+	GranularityMonth StatsGranularity = "month"
+)
+
+// StatsQuery selects the time range and granularity of link statistics.
+// Zero values use the server defaults.
+type StatsQuery struct {
+	Granularity StatsGranularity
+	From        time.Time
+	To          time.Time
+}
+
+func (q StatsQuery) values() url.Values {
+	query := url.Values{}
+	if q.Granularity != "" {
+		query.Set("granularity", string(q.Granularity))
+	}
+	if !q.From.IsZero() {
+		query.Set("from", strconv.FormatInt(q.From.Unix(), 10))
+	}
+	if !q.To.IsZero() {
+		query.Set("to", strconv.FormatInt(q.To.Unix(), 10))
+	}
+	return query
+}
+
+// LinkRef identifies a short URL.
+type LinkRef struct {
+	Domain string
+	Slug   string
+}
+
+// GetLinkStats retrieves click statistics for a short URL.
+func (c *Client) GetLinkStats(ctx context.Context, domain, slug string, q StatsQuery) (*LinkStatsResponse, error) {
+	query := q.values()
+	query.Set("domain", domain)
+	query.Set("slug", slug)
+
+	respBody, err := c.doRequestContext(ctx, "GET", "/shorten/stats?"+query.Encode(), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response LinkStatsResponse
+	if err := unmarshalResponse(respBody, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// AggregateLinkStats fetches the statistics of each link and merges them
+// with MergeLinkStats. Links are fetched one at a time.
+func (c *Client) AggregateLinkStats(ctx context.Context, links []LinkRef, q StatsQuery) (*LinkStats, error) {
+	stats := make([]*LinkStats, 0, len(links))
+	for _, link := range links {
+		resp, err := c.GetLinkStats(ctx, link.Domain, link.Slug, q)
+		if err != nil {
+			return nil, fmt.Errorf("get stats for %s/%s: %w", link.Domain, link.Slug, err)
+		}
+		stats = append(stats, &resp.Data)
+	}
+	return MergeLinkStats(stats...), nil
+}
+
+// GetTagStats aggregates the statistics of all short URLs carrying a tag.
+func (c *Client) GetTagStats(ctx context.Context, tagID int64, q StatsQuery) (*LinkStats, error) {
+	return c.aggregateListed(ctx, ListOptions{TagID: tagID}, q)
+}
+
+// GetDomainStats aggregates the statistics of all short URLs on a domain.
+func (c *Client) GetDomainStats(ctx context.Context, domain string, q StatsQuery) (*LinkStats, error) {
+	return c.aggregateListed(ctx, ListOptions{Domain: domain}, q)
+}
+
+func (c *Client) aggregateListed(ctx context.Context, opts ListOptions, q StatsQuery) (*LinkStats, error) {
+	var links []LinkRef
+	it := c.ShortURLs(ctx, opts)
+	for it.Next() {
+		link := it.Value()
+		links = append(links, LinkRef{Domain: link.Domain, Slug: link.Slug})
+	}
+	if err := it.Err(); err != nil {
+		return nil, fmt.Errorf("list short URLs: %w", err)
+	}
+	return c.AggregateLinkStats(ctx, links, q)
+}
+
+// MergeLinkStats sums the statistics of several links. Time series buckets
+// are matched by timestamp and breakdowns by key. Unique visitors are
+// summed as well, so they are an upper bound for the merged set.
+func MergeLinkStats(stats ...*LinkStats) *LinkStats {
+	merged := &LinkStats{}
+	series := map[int64]*StatsPoint{}
+	referrers := map[string]int64{}
+	countries := map[string]int64{}
+	devices := map[string]int64{}
+	browsers := map[string]int64{}
+
+	for _, s := range stats {
+		if s == nil {
+			continue
+		}
+		merged.TotalClicks += s.TotalClicks
+		merged.UniqueVisitors += s.UniqueVisitors
+		for _, p := range s.Series {
+			key := p.Timestamp.Unix()
+			point, ok := series[key]
+			if !ok {
+				point = &StatsPoint{Timestamp: p.Timestamp}
+				series[key] = point
+			}
+			point.Clicks += p.Clicks
+			point.UniqueVisitors += p.UniqueVisitors
+		}
+		addBreakdown(referrers, s.Referrers)
+		addBreakdown(countries, s.Countries)
+		addBreakdown(devices, s.Devices)
+		addBreakdown(browsers, s.Browsers)
+	}
+
+	for _, p := range series {
+		merged.Series = append(merged.Series, *p)
+	}
+	sort.Slice(merged.Series, func(i, j int) bool {
+		return merged.Series[i].Timestamp.Before(merged.Series[j].Timestamp.Time)
+	})
+	merged.Referrers = sortedBreakdown(referrers)
+	merged.Countries = sortedBreakdown(countries)
+	merged.Devices = sortedBreakdown(devices)
+	merged.Browsers = sortedBreakdown(browsers)
+	return merged
+}
+
+func addBreakdown(totals map[string]int64, breakdown []StatsBreakdown) {
+	for _, b := range breakdown {
+		totals[b.Key] += b.Clicks
+	}
+}
+
+// sortedBreakdown orders breakdown entries by clicks, most first.
+func sortedBreakdown(totals map[string]int64) []StatsBreakdown {
+	breakdown := make([]StatsBreakdown, 0, len(totals))
+	for key, clicks := range totals {
+		breakdown = append(breakdown, StatsBreakdown{Key: key, Clicks: clicks})
+	}
+	sort.Slice(breakdown, func(i, j int) bool {
+		if breakdown[i].Clicks != breakdown[j].Clicks {
+			return breakdown[i].Clicks > breakdown[j].Clicks
+		}
+		return breakdown[i].Key < breakdown[j].Key
+	})
+	return breakdown
+}
@@ -0,0 +1,264 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: stats_export.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:26:10
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 05:17:51
+//
+
+package seesdk
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// StatsExportSchemaVersion is the version of the statistics export schema.
+const StatsExportSchemaVersion = 1
+
+// Sections of an exported statistics row.
+const (
+	StatsSectionTotal    = "total"
+	StatsSectionSeries   = "series"
+	StatsSectionReferrer = "referrer"
+	StatsSectionCountry  = "country"
+	StatsSectionDevice   = "device"
+	StatsSectionBrowser  = "browser"
+)
+
+// StatsColumn describes one column of exported statistics.
+type StatsColumn struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Nullable    bool   `json:"nullable"`
+	Description string `json:"description"`
+}
+
+// StatsExportSchema describes the columns written by the statistics
+// exporters, in CSV column order. NDJSON writes null values as null, while
+// CSV writes them as empty fields, so in CSV a null key cannot be told apart
+// from an empty breakdown key. Use the section to interpret the key, or
+// NDJSON when the difference matters.
+var StatsExportSchema = []StatsColumn{
+	{Name: "domain", Type: "string", Description: "Short URL domain"},
+	{Name: "slug", Type: "string", Description: "Short URL slug"},
+	{Name: "section", Type: "string", Description: "One of total, series, referrer, country, device, browser"},
+	{Name: "key", Type: "string", Nullable: true, Description: "Breakdown key such as the referrer or country code; null for total and series rows"},
+	{Name: "timestamp", Type: "timestamp", Nullable: true, Description: "RFC 3339 start of the bucket in UTC; null for rows other than series"},
+	{Name: "clicks", Type: "int64", Description: "Number of clicks"},
+	{Name: "unique_visitors", Type: "int64", Nullable: true, Description: "Number of unique visitors; null for breakdown rows"},
+}
+
+// WriteStatsSchema writes a JSON schema document for the exported columns.
+func WriteStatsSchema(w io.Writer) error {
+	doc := struct {
+		Name    string        `json:"name"`
+		Version int           `json:"version"`
+		Formats []string      `json:"formats"`
+		Columns []StatsColumn `json:"columns"`
+	}{
+		Name:    "see_link_stats",
+		Version: StatsExportSchemaVersion,
+		Formats: []string{"csv", "ndjson"},
+		Columns: StatsExportSchema,
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(doc); err != nil {
+		return fmt.Errorf("write schema: %w", err)
+	}
+	return nil
+}
+
+// StatsRow is one row of exported statistics.
+type StatsRow struct {
+	Domain         string     `json:"domain"`
+	Slug           string     `json:"slug"`
+	Section        string     `json:"section"`
+	Key            *string    `json:"key"`
+	Timestamp      *time.Time `json:"timestamp"`
+	Clicks         int64      `json:"clicks"`
+	UniqueVisitors *int64     `json:"unique_visitors"`
+}
+
+// StatsRows flattens the statistics of a link into rows.
+func StatsRows(link LinkRef, stats *LinkStats) []StatsRow {
+	rows := make([]StatsRow, 0, 1+len(stats.Series)+len(stats.Referrers)+
+		len(stats.Countries)+len(stats.Devices)+len(stats.Browsers))
+
+	unique := stats.UniqueVisitors
+	rows = append(rows, StatsRow{
+		Domain:         link.Domain,
+		Slug:           link.Slug,
+		Section:        StatsSectionTotal,
+		Clicks:         stats.TotalClicks,
+		UniqueVisitors: &unique,
+	})
+
+	for _, p := range stats.Series {
+		ts := p.Timestamp.UTC()
+		unique := p.UniqueVisitors
+		rows = append(rows, StatsRow{
+			Domain:         link.Domain,
+			Slug:           link.Slug,
+			Section:        StatsSectionSeries,
+			Timestamp:      &ts,
+			Clicks:         p.Clicks,
+			UniqueVisitors: &unique,
+		})
+	}
+
+	for _, section := range []struct {
+		name      string
+		breakdown []StatsBreakdown
+	}{
+		{StatsSectionReferrer, stats.Referrers},
+		{StatsSectionCountry, stats.Countries},
+		{StatsSectionDevice, stats.Devices},
+		{StatsSectionBrowser, stats.Browsers},
+	} {
+		for _, b := range section.breakdown {
+			key := b.Key
+			rows = append(rows, StatsRow{
+				Domain:  link.Domain,
+				Slug:    link.Slug,
+				Section: section.name,
+				Key:     &key,
+				Clicks:  b.Clicks,
+			})
+		}
+	}
+
+	return rows
+}
+
+// StatsExporter writes link statistics as rows described by StatsExportSchema.
+type StatsExporter interface {
+	// WriteStats writes all rows for one link.
+	WriteStats(link LinkRef, stats *LinkStats) error
+	// Close flushes buffered output. It does not close the underlying writer.
+	Close() error
+}
+
+// csvStatsExporter writes rows as CSV with a header line. Null values are
+// written as empty fields, like empty strings.
+type csvStatsExporter struct {
+	w             *csv.Writer
+	headerWritten bool
+}
+
+// NewCSVStatsExporter returns a StatsExporter that writes CSV to w.
+func NewCSVStatsExporter(w io.Writer) StatsExporter {
+	return &csvStatsExporter{w: csv.NewWriter(w)}
+}
+
+func (e *csvStatsExporter) WriteStats(link LinkRef, stats *LinkStats) error {
+	if !e.headerWritten {
+		header := make([]string, len(StatsExportSchema))
+		for i, column := range StatsExportSchema {
+			header[i] = column.Name
+		}
+		if err := e.w.Write(header); err != nil {
+			return fmt.Errorf("write csv header: %w", err)
+		}
+		e.headerWritten = true
+	}
+
+	for _, row := range StatsRows(link, stats) {
+		record := []string{row.Domain, row.Slug, row.Section, "", "", strconv.FormatInt(row.Clicks, 10), ""}
+		if row.Key != nil {
+			record[3] = *row.Key
+		}
+		if row.Timestamp != nil {
+			record[4] = row.Timestamp.Format(time.RFC3339)
+		}
+		if row.UniqueVisitors != nil {
+			record[6] = strconv.FormatInt(*row.UniqueVisitors, 10)
+		}
+		if err := e.w.Write(record); err != nil {
+			return fmt.Errorf("write csv row: %w", err)
+		}
+	}
+
+	// Flush per link so that long exports stream out steadily.
+	e.w.Flush()
+	return e.w.Error()
+}
+
+func (e *csvStatsExporter) Close() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+// ndjsonStatsExporter writes one JSON object per row.
+type ndjsonStatsExporter struct {
+	buf     *bufio.Writer
+	encoder *json.Encoder
+}
+
+// NewNDJSONStatsExporter returns a StatsExporter that writes newline-delimited JSON to w.
+func NewNDJSONStatsExporter(w io.Writer) StatsExporter {
+	buf := bufio.NewWriter(w)
+	return &ndjsonStatsExporter{buf: buf, encoder: json.NewEncoder(buf)}
+}
+
+func (e *ndjsonStatsExporter) WriteStats(link LinkRef, stats *LinkStats) error {
+	for _, row := range StatsRows(link, stats) {
+		if err := e.encoder.Encode(row); err != nil {
+			return fmt.Errorf("write json row: %w", err)
+		}
+	}
+	return e.buf.Flush()
+}
+
+func (e *ndjsonStatsExporter) Close() error {
+	return e.buf.Flush()
+}
+
+// ExportLinkStats fetches the statistics of each link and writes them to
+// exp as they arrive. The exporter is not closed.
+func (c *Client) ExportLinkStats(ctx context.Context, exp StatsExporter, links []LinkRef, q StatsQuery) error {
+	for _, link := range links {
+		if err := c.exportOne(ctx, exp, link, q); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExportListedStats streams the statistics of every short URL matching opts
+// to exp, paging through the list as it goes. The exporter is not closed.
+func (c *Client) ExportListedStats(ctx context.Context, exp StatsExporter, opts ListOptions, q StatsQuery) error {
+	it := c.ShortURLs(ctx, opts)
+	for it.Next() {
+		link := it.Value()
+		if err := c.exportOne(ctx, exp, LinkRef{Domain: link.Domain, Slug: link.Slug}, q); err != nil {
+			return err
+		}
+	}
+	if err := it.Err(); err != nil {
+		return fmt.Errorf("list short URLs: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) exportOne(ctx context.Context, exp StatsExporter, link LinkRef, q StatsQuery) error {
+	resp, err := c.GetLinkStats(ctx, link.Domain, link.Slug, q)
+	if err != nil {
+		return fmt.Errorf("get stats for %s/%s: %w", link.Domain, link.Slug, err)
+	}
+	return exp.WriteStats(link, &resp.Data)
+}
@@ -0,0 +1,67 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: stats_export_test.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 05:00:11
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 05:00:11
+//
+
+package seesdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestStatsExporters(t *testing.T) {
+	link := LinkRef{Domain: "s.ee", Slug: "abc"}
+	stats := &LinkStats{
+		TotalClicks:    3,
+		UniqueVisitors: 2,
+		Series:         []StatsPoint{{Timestamp: unix(0), Clicks: 3, UniqueVisitors: 2}},
+		Browsers:       []StatsBreakdown{{Key: "Firefox", Clicks: 3}},
+	}
+
+	var csvOut bytes.Buffer
+	exp := NewCSVStatsExporter(&csvOut)
+	if err := exp.WriteStats(link, stats); err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	if err := exp.Close(); err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+
+	want := "domain,slug,section,key,timestamp,clicks,unique_visitors\n" +
+		"s.ee,abc,total,,,3,2\n" +
+		"s.ee,abc,series,,1970-01-01T00:00:00Z,3,2\n" +
+		"s.ee,abc,browser,Firefox,,3,\n"
+	if csvOut.String() != want {
+		t.Errorf("Unexpected CSV output:\n%s", csvOut.String())
+	}
+
+	var jsonOut bytes.Buffer
+	exp = NewNDJSONStatsExporter(&jsonOut)
+	if err := exp.WriteStats(link, stats); err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(jsonOut.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("Expected 3 rows, got %d", len(lines))
+	}
+	var row map[string]any
+	if err := json.Unmarshal([]byte(lines[2]), &row); err != nil {
+		t.Fatal(err)
+	}
+	if len(row) != len(StatsExportSchema) || row["key"] != "Firefox" || row["unique_visitors"] != nil {
+		t.Errorf("Unexpected JSON row: %s", lines[2])
+	}
+}
@@ -0,0 +1,128 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: stats_test.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:26:23
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 05:00:23
+//
+
+package seesdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func unix(sec int64) Timestamp {
+	return Timestamp{time.Unix(sec, 0)}
+}
+
+func TestMergeLinkStats(t *testing.T) {
+	merged := MergeLinkStats(
+		&LinkStats{
+			TotalClicks: 3,
+			Series:      []StatsPoint{{Timestamp: unix(200), Clicks: 2}, {Timestamp: unix(100), Clicks: 1}},
+			Countries:   []StatsBreakdown{{Key: "US", Clicks: 2}, {Key: "DE", Clicks: 1}},
+		},
+		&LinkStats{
+			TotalClicks: 4,
+			Series:      []StatsPoint{{Timestamp: unix(100), Clicks: 4}},
+			Countries:   []StatsBreakdown{{Key: "DE", Clicks: 4}},
+		},
+	)
+
+	if merged.TotalClicks != 7 {
+		t.Errorf("Expected 7 total clicks, got %d", merged.TotalClicks)
+	}
+	if len(merged.Series) != 2 || merged.Series[0].Timestamp.Unix() != 100 || merged.Series[0].Clicks != 5 {
+		t.Errorf("Expected series sorted by time with merged buckets, got %+v", merged.Series)
+	}
+	if merged.Countries[0].Key != "DE" || merged.Countries[0].Clicks != 5 {
+		t.Errorf("Expected DE first with 5 clicks, got %+v", merged.Countries)
+	}
+}
+
+func TestGetLinkStats(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/shorten/stats" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		want := "domain=s.ee&from=100&granularity=day&slug=a%2Fb&to=200"
+		if r.URL.RawQuery != want {
+			t.Errorf("Expected query %s, got %s", want, r.URL.RawQuery)
+		}
+		w.Write([]byte(`{"code":200,"data":{"total_clicks":5,"series":[{"timestamp":100,"clicks":5}]}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{BaseURL: server.URL})
+
+	resp, err := client.GetLinkStats(context.Background(), "s.ee", "a/b", StatsQuery{
+		Granularity: GranularityDay,
+		From:        time.Unix(100, 0),
+		To:          time.Unix(200, 0),
+	})
+	if err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	if resp.Data.TotalClicks != 5 || len(resp.Data.Series) != 1 || resp.Data.Series[0].Timestamp.Unix() != 100 {
+		t.Errorf("Expected stats to be decoded, got %+v", resp.Data)
+	}
+}
+
+func TestGetTagAndDomainStats(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		switch r.URL.Path {
+		case "/shorten/list":
+			var items string
+			switch {
+			case query.Get("tag_id") == "7":
+				items = `{"domain":"s.ee","slug":"a"},{"domain":"x.ee","slug":"b"}`
+			case query.Get("domain") == "s.ee":
+				items = `{"domain":"s.ee","slug":"a"}`
+			default:
+				t.Errorf("Unexpected list query %s", r.URL.RawQuery)
+			}
+			fmt.Fprintf(w, `{"code":200,"data":{"items":[%s],"page":1,"page_size":50,"total":0}}`, items)
+		case "/shorten/stats":
+			if query.Get("granularity") != "week" {
+				t.Errorf("Expected granularity to be forwarded, got %s", r.URL.RawQuery)
+			}
+			clicks := map[string]int{"a": 2, "b": 3}[query.Get("slug")]
+			fmt.Fprintf(w, `{"code":200,"data":{"total_clicks":%d}}`, clicks)
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{BaseURL: server.URL})
+	q := StatsQuery{Granularity: GranularityWeek}
+
+	stats, err := client.GetTagStats(context.Background(), 7, q)
+	if err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	if stats.TotalClicks != 5 {
+		t.Errorf("Expected 5 clicks for the tag, got %d", stats.TotalClicks)
+	}
+
+	stats, err = client.GetDomainStats(context.Background(), "s.ee", q)
+	if err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	if stats.TotalClicks != 2 {
+		t.Errorf("Expected 2 clicks for the domain, got %d", stats.TotalClicks)
+	}
+}
@@ -0,0 +1,297 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: tags.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:23:23
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 05:16:52
+//
+
+package seesdk
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultTagCacheTTL is the default lifetime of cached tag lists.
+const DefaultTagCacheTTL = 5 * time.Minute
+
+// tagMissTTL is how long a name that is unknown after a refresh is
+// remembered, so that repeated lookups of it do not refetch the tags.
+const tagMissTTL = 30 * time.Second
+
+// CreateTag creates a new tag.
+func (c *Client) CreateTag(req CreateTagRequest) (*TagResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	respBody, err := c.doRequest("POST", "/tags", req)
+	if err != nil {
+		return nil, err
+	}
+	c.tagResolver().Invalidate()
+
+	var response TagResponse
+	if err := unmarshalResponse(respBody, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// RenameTag renames an existing tag.
+func (c *Client) RenameTag(req RenameTagRequest) (*TagResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	respBody, err := c.doRequest("PUT", "/tags", req)
+	if err != nil {
+		return nil, err
+	}
+	c.tagResolver().Invalidate()
+
+	var response TagResponse
+	if err := unmarshalResponse(respBody, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// DeleteTag deletes an existing tag. Links carrying the tag are kept.
+func (c *Client) DeleteTag(req DeleteTagRequest) (*DeleteTagResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	respBody, err := c.doRequest("DELETE", "/tags", req)
+	if err != nil {
+		return nil, err
+	}
+	c.tagResolver().Invalidate()
+
+	var response DeleteTagResponse
+	if err := unmarshalResponse(respBody, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// MergeTags retags every link and text carrying the source tag with the
+// target tag and then deletes the source tag.
+func (c *Client) MergeTags(req MergeTagsRequest) (*MergeTagsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	respBody, err := c.doRequest("POST", "/tags/merge", req)
+	if err != nil {
+		return nil, err
+	}
+	c.tagResolver().Invalidate()
+
+	var response MergeTagsResponse
+	if err := unmarshalResponse(respBody, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// UnknownTagsError is returned when tag names cannot be resolved to IDs.
+type UnknownTagsError struct {
+	Names []string
+}
+
+func (e *UnknownTagsError) Error() string {
+	return fmt.Sprintf("unknown tags: %s", strings.Join(e.Names, ", "))
+}
+
+// TagResolver maps tag names to IDs using a cached copy of GetTags.
+// It is safe for concurrent use; concurrent refreshes share one request.
+type TagResolver struct {
+	client *Client
+	ttl    time.Duration
+	group  flightGroup[map[string]int64]
+
+	mu      sync.Mutex
+	ids     map[string]int64 // never modified once stored
+	fetched time.Time
+	misses  map[string]time.Time // unknown names and when they were looked up
+	gen     uint64               // incremented by Invalidate
+}
+
+// NewTagResolver creates a TagResolver whose cache expires after ttl
+// (default: DefaultTagCacheTTL).
+func NewTagResolver(client *Client, ttl time.Duration) *TagResolver {
+	if ttl <= 0 {
+		ttl = DefaultTagCacheTTL
+	}
+	return &TagResolver{client: client, ttl: ttl}
+}
+
+// Resolve returns the IDs of the named tags in the same order. If any name
+// is unknown after refreshing the cache, an *UnknownTagsError listing all
+// unknown names is returned. A name that was unknown less than 30 seconds
+// ago does not trigger another refresh.
+func (r *TagResolver) Resolve(names ...string) ([]int64, error) {
+	r.mu.Lock()
+	cached := r.ids
+	stale := cached == nil || time.Since(r.fetched) > r.ttl
+	r.mu.Unlock()
+
+	if stale {
+		var err error
+		if cached, err = r.refresh(); err != nil {
+			return nil, err
+		}
+	}
+
+	ids, unknown := lookupTags(cached, names)
+	if len(unknown) > 0 && !stale && r.hasNewMiss(unknown) {
+		// The tag may have been created since the last refresh.
+		var err error
+		if cached, err = r.refresh(); err != nil {
+			return nil, err
+		}
+		ids, unknown = lookupTags(cached, names)
+	}
+	if len(unknown) > 0 {
+		r.mu.Lock()
+		if r.misses == nil {
+			r.misses = map[string]time.Time{}
+		}
+		now := time.Now()
+		for _, name := range unknown {
+			if _, ok := r.misses[name]; !ok {
+				r.misses[name] = now
+			}
+		}
+		r.mu.Unlock()
+		return nil, &UnknownTagsError{Names: unknown}
+	}
+
+	return ids, nil
+}
+
+// Invalidate drops the cached tag list so that the next Resolve refetches it.
+func (r *TagResolver) Invalidate() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.ids = nil
+	r.misses = nil
+	r.gen++
+}
+
+// hasNewMiss reports whether any unknown name has not been looked up
+// within tagMissTTL.
+func (r *TagResolver) hasNewMiss(unknown []string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, name := range unknown {
+		if at, ok := r.misses[name]; !ok || time.Since(at) > tagMissTTL {
+			return true
+		}
+	}
+	return false
+}
+
+func lookupTags(cached map[string]int64, names []string) ([]int64, []string) {
+	ids := make([]int64, 0, len(names))
+	var unknown []string
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		id, ok := cached[name]
+		if !ok {
+			unknown = append(unknown, name)
+			continue
+		}
+		ids = append(ids, id)
+	}
+	sort.Strings(unknown)
+	return ids, unknown
+}
+
+// refresh fetches the tags without holding r.mu and stores them unless the
+// cache was invalidated meanwhile. Callers share a fetch started after the
+// last Invalidate.
+func (r *TagResolver) refresh() (map[string]int64, error) {
+	r.mu.Lock()
+	gen := r.gen
+	r.mu.Unlock()
+
+	return r.group.Do(strconv.FormatUint(gen, 10), func() (map[string]int64, error) {
+		tags, err := r.client.GetTags()
+		if err != nil {
+			return nil, fmt.Errorf("get tags: %w", err)
+		}
+
+		ids := make(map[string]int64, len(tags.Data.Tags))
+		for _, tag := range tags.Data.Tags {
+			ids[strings.TrimSpace(tag.Name)] = int64(tag.ID)
+		}
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if r.gen == gen {
+			r.ids = ids
+			r.fetched = time.Now()
+			r.misses = nil
+		}
+		return ids, nil
+	})
+}
+
+// tagResolver returns the client's shared TagResolver.
+func (c *Client) tagResolver() *TagResolver {
+	c.tagsOnce.Do(func() {
+		c.tags = NewTagResolver(c, DefaultTagCacheTTL)
+	})
+	return c.tags
+}
+
+// resolveTagNames returns ids extended with the IDs of the named tags,
+// without duplicates.
+func (c *Client) resolveTagNames(ids []int64, names []string) ([]int64, error) {
+	if len(names) == 0 {
+		return ids, nil
+	}
+
+	resolved, err := c.tagResolver().Resolve(names...)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := make([]int64, 0, len(ids)+len(resolved))
+	seen := make(map[int64]bool, len(ids)+len(resolved))
+	for _, id := range append(append([]int64{}, ids...), resolved...) {
+		if !seen[id] {
+			seen[id] = true
+			merged = append(merged, id)
+		}
+	}
+	return merged, nil
+}
+
+// invalidateTagNames drops the cached tags after a failed request that used
+// tag names, as a stale ID may have caused the failure.
+func (c *Client) invalidateTagNames(names []string) {
+	if len(names) > 0 {
+		c.tagResolver().Invalidate()
+	}
+}
@@ -0,0 +1,282 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: tags_test.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:23:56
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 05:16:52
+//
+
+package seesdk
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCreateShortURLTagNames(t *testing.T) {
+	tagRequests := 0
+	var sent CreateShortURLRequest
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/tags":
+			tagRequests++
+			w.Write([]byte(`{"code":200,"data":{"tags":[{"id":1,"name":"go"},{"id":2,"name":"sdk"}]}}`))
+		case "/shorten":
+			json.NewDecoder(r.Body).Decode(&sent)
+			w.Write([]byte(`{"code":200,"data":{"slug":"abc"}}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{BaseURL: server.URL})
+
+	_, err := client.CreateShortURL(CreateShortURLRequest{
+		Domain:    "s.ee",
+		TargetURL: "https://example.com",
+		TagIDs:    []int64{2},
+		TagNames:  []string{"go", "sdk"},
+	})
+	if err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	if !reflect.DeepEqual(sent.TagIDs, []int64{2, 1}) {
+		t.Errorf("Expected tag IDs [2 1], got %v", sent.TagIDs)
+	}
+
+	_, err = client.CreateShortURL(CreateShortURLRequest{
+		Domain:    "s.ee",
+		TargetURL: "https://example.com",
+		TagNames:  []string{"go", "rust", "java"},
+	})
+	var unknown *UnknownTagsError
+	if !errors.As(err, &unknown) {
+		t.Fatalf("Expected UnknownTagsError, got: %v", err)
+	}
+	if !reflect.DeepEqual(unknown.Names, []string{"java", "rust"}) {
+		t.Errorf("Expected unknown names [java rust], got %v", unknown.Names)
+	}
+
+	// The first call fetched the tags, the second refetched once before failing.
+	if tagRequests != 2 {
+		t.Errorf("Expected 2 tag list requests, got %d", tagRequests)
+	}
+}
+
+func TestTagCRUD(t *testing.T) {
+	var method, path string
+	var body map[string]any
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		body = nil
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Write([]byte(`{"code":200,"data":{"id":3,"name":"go"}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{BaseURL: server.URL})
+
+	tests := []struct {
+		name   string
+		call   func() error
+		method string
+		path   string
+		body   map[string]any
+	}{
+		{
+			name: "create",
+			call: func() error {
+				_, err := client.CreateTag(CreateTagRequest{Name: "go"})
+				return err
+			},
+			method: "POST", path: "/tags",
+			body: map[string]any{"name": "go"},
+		},
+		{
+			name: "rename",
+			call: func() error {
+				_, err := client.RenameTag(RenameTagRequest{ID: 3, Name: "golang"})
+				return err
+			},
+			method: "PUT", path: "/tags",
+			body: map[string]any{"id": float64(3), "name": "golang"},
+		},
+		{
+			name: "delete",
+			call: func() error {
+				_, err := client.DeleteTag(DeleteTagRequest{ID: 3})
+				return err
+			},
+			method: "DELETE", path: "/tags",
+			body: map[string]any{"id": float64(3)},
+		},
+		{
+			name: "merge",
+			call: func() error {
+				_, err := client.MergeTags(MergeTagsRequest{SourceID: 3, TargetID: 4})
+				return err
+			},
+			method: "POST", path: "/tags/merge",
+			body: map[string]any{"source_id": float64(3), "target_id": float64(4)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Seed the shared resolver so the call is seen to invalidate it.
+			client.tagResolver().mu.Lock()
+			client.tagResolver().ids = map[string]int64{"go": 3}
+			client.tagResolver().mu.Unlock()
+
+			if err := tt.call(); err != nil {
+				t.Fatal("Expected no error, got:", err)
+			}
+			if method != tt.method || path != tt.path {
+				t.Errorf("Expected %s %s, got %s %s", tt.method, tt.path, method, path)
+			}
+			if !reflect.DeepEqual(body, tt.body) {
+				t.Errorf("Expected body %v, got %v", tt.body, body)
+			}
+			if client.tagResolver().ids != nil {
+				t.Error("Expected tag cache to be invalidated")
+			}
+		})
+	}
+
+	if _, err := client.MergeTags(MergeTagsRequest{SourceID: 3, TargetID: 3}); err == nil {
+		t.Error("Expected validation error when merging a tag into itself")
+	}
+}
+
+func TestTagResolver(t *testing.T) {
+	tagRequests := 0
+	tags := `[{"id":1,"name":"go"}]`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tagRequests++
+		w.Write([]byte(`{"code":200,"data":{"tags":` + tags + `}}`))
+	}))
+	defer server.Close()
+
+	resolver := NewTagResolver(NewClient(Config{BaseURL: server.URL}), time.Hour)
+
+	ids, err := resolver.Resolve("go", " go ")
+	if err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	if !reflect.DeepEqual(ids, []int64{1, 1}) {
+		t.Errorf("Expected IDs [1 1], got %v", ids)
+	}
+
+	// A cached hit does not refetch.
+	resolver.Resolve("go")
+	if tagRequests != 1 {
+		t.Errorf("Expected 1 tag list request, got %d", tagRequests)
+	}
+
+	// An unknown name refetches once; the tag was created meanwhile.
+	tags = `[{"id":1,"name":"go"},{"id":2,"name":" sdk "}]`
+	ids, err = resolver.Resolve("sdk")
+	if err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	if !reflect.DeepEqual(ids, []int64{2}) || tagRequests != 2 {
+		t.Errorf("Expected IDs [2] after 2 requests, got %v after %d", ids, tagRequests)
+	}
+
+	// A name that stays unknown is not refetched on every call.
+	for i := 0; i < 3; i++ {
+		var unknown *UnknownTagsError
+		if _, err := resolver.Resolve("rust"); !errors.As(err, &unknown) {
+			t.Fatalf("Expected UnknownTagsError, got: %v", err)
+		}
+	}
+	if tagRequests != 3 {
+		t.Errorf("Expected 3 tag list requests, got %d", tagRequests)
+	}
+
+	// Invalidate forgets both the tags and the unknown names.
+	tags = `[{"id":3,"name":"rust"}]`
+	resolver.Invalidate()
+	ids, err = resolver.Resolve("rust")
+	if err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	if !reflect.DeepEqual(ids, []int64{3}) || tagRequests != 4 {
+		t.Errorf("Expected IDs [3] after 4 requests, got %v after %d", ids, tagRequests)
+	}
+
+	// An expired cache is refetched even for known names.
+	resolver.fetched = time.Now().Add(-2 * time.Hour)
+	resolver.Resolve("rust")
+	if tagRequests != 5 {
+		t.Errorf("Expected 5 tag list requests, got %d", tagRequests)
+	}
+}
+
+func TestTagResolverConcurrentRefresh(t *testing.T) {
+	var mu sync.Mutex
+	tagRequests := 0
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		tagRequests++
+		mu.Unlock()
+		select {
+		case started <- struct{}{}:
+		default:
+		}
+		<-release
+		w.Write([]byte(`{"code":200,"data":{"tags":[{"id":1,"name":"go"}]}}`))
+	}))
+	defer server.Close()
+
+	resolver := NewTagResolver(NewClient(Config{BaseURL: server.URL}), time.Hour)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := resolver.Resolve("go"); err != nil {
+				t.Error("Expected no error, got:", err)
+			}
+		}()
+	}
+	<-started
+
+	// The lock is not held while the tags are fetched.
+	locked := make(chan struct{})
+	go func() {
+		resolver.mu.Lock()
+		resolver.mu.Unlock()
+		close(locked)
+	}()
+	select {
+	case <-locked:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected the resolver lock to be free during the fetch")
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	if tagRequests != 1 {
+		t.Errorf("Expected concurrent refreshes to share 1 request, got %d", tagRequests)
+	}
+}
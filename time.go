@@ -0,0 +1,115 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: time.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:30:41
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 04:30:41
+//
+
+package seesdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// maxExpiryHorizon bounds how far in the future an expiry may be. Anything
+// later is almost certainly a timestamp in milliseconds.
+const maxExpiryHorizon = 100 * 365 * 24 * time.Hour
+
+// Expiry is an expiration time encoded as a Unix timestamp in seconds.
+// The zero value means the resource never expires.
+type Expiry int64
+
+// ExpiresAt returns the Expiry for t.
+func ExpiresAt(t time.Time) Expiry {
+	return Expiry(t.Unix())
+}
+
+// ExpiresIn returns the Expiry d from now.
+func ExpiresIn(d time.Duration) Expiry {
+	return ExpiresAt(time.Now().Add(d))
+}
+
+// IsZero reports whether the expiry is unset.
+func (e Expiry) IsZero() bool {
+	return e == 0
+}
+
+// Time returns the expiry as a time.Time, or the zero time if unset.
+func (e Expiry) Time() time.Time {
+	if e == 0 {
+		return time.Time{}
+	}
+	return time.Unix(int64(e), 0)
+}
+
+// Validate rejects expiries in the past and implausibly distant ones, such
+// as timestamps accidentally given in milliseconds. An unset expiry is valid.
+func (e Expiry) Validate() error {
+	if e == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	t := e.Time()
+	if !t.After(now) {
+		return fmt.Errorf("expiry %s is in the past", t.UTC().Format(time.RFC3339))
+	}
+	if t.After(now.Add(maxExpiryHorizon)) {
+		if e > 1e11 {
+			return fmt.Errorf("expiry %d is too far in the future, it looks like milliseconds", int64(e))
+		}
+		return fmt.Errorf("expiry %s is too far in the future", t.UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+// Timestamp is a point in time encoded as a Unix timestamp in seconds.
+// A zero or null timestamp decodes to the zero time.
+type Timestamp struct {
+	time.Time
+}
+
+// MarshalJSON encodes the time as Unix seconds, or 0 for the zero time.
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("0"), nil
+	}
+	return []byte(strconv.FormatInt(t.Unix(), 10)), nil
+}
+
+// UnmarshalJSON decodes Unix seconds, with an optional fractional part.
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		t.Time = time.Time{}
+		return nil
+	}
+
+	var seconds json.Number
+	if err := json.Unmarshal(data, &seconds); err != nil {
+		return fmt.Errorf("decode timestamp: %w", err)
+	}
+	if n, err := seconds.Int64(); err == nil {
+		t.Time = time.Time{}
+		if n != 0 {
+			t.Time = time.Unix(n, 0)
+		}
+		return nil
+	}
+	f, err := seconds.Float64()
+	if err != nil {
+		return fmt.Errorf("decode timestamp: %w", err)
+	}
+	t.Time = time.Unix(0, int64(f*float64(time.Second)))
+	return nil
+}
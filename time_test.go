@@ -0,0 +1,63 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: time_test.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:31:10
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 04:31:10
+//
+
+package seesdk
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestExpiryValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		expiry  Expiry
+		wantErr bool
+	}{
+		{"unset", 0, false},
+		{"in a day", ExpiresIn(24 * time.Hour), false},
+		{"in the past", ExpiresAt(time.Now().Add(-time.Hour)), true},
+		{"milliseconds", Expiry(time.Now().Add(24 * time.Hour).UnixMilli()), true},
+	}
+
+	for _, tt := range tests {
+		if err := tt.expiry.Validate(); (err != nil) != tt.wantErr {
+			t.Errorf("%s: expected error %v, got: %v", tt.name, tt.wantErr, err)
+		}
+	}
+}
+
+func TestTimestampJSON(t *testing.T) {
+	var v struct {
+		A Timestamp `json:"a"`
+		B Timestamp `json:"b"`
+		C Timestamp `json:"c"`
+	}
+	if err := json.Unmarshal([]byte(`{"a":1700000000,"b":0,"c":null}`), &v); err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+
+	if !v.A.Equal(time.Unix(1700000000, 0)) {
+		t.Errorf("Expected a to be 1700000000, got %v", v.A)
+	}
+	if !v.B.IsZero() || !v.C.IsZero() {
+		t.Error("Expected b and c to be the zero time")
+	}
+
+	data, err := json.Marshal(v.A)
+	if err != nil || string(data) != "1700000000" {
+		t.Errorf("Expected 1700000000, got %s (%v)", data, err)
+	}
+}
@@ -0,0 +1,138 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: upload_url.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:22:50
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 04:52:31
+//
+
+package seesdk
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+)
+
+// UploadFromURLOptions contains options for UploadFromURL.
+type UploadFromURLOptions struct {
+	// Filename overrides the name derived from the source response.
+	Filename string
+	// MaxSize is the maximum number of bytes to transfer (default: 100MB).
+	MaxSize int64
+}
+
+// UploadFromURL streams the resource at sourceURL into a file upload without
+// buffering it on disk or in memory. The filename is taken from the upstream
+// Content-Disposition header or the URL path, and the upstream Content-Type
+// is forwarded with the file. The client timeout does not apply; use ctx to
+// bound the transfer.
+func (c *Client) UploadFromURL(ctx context.Context, sourceURL string, opts UploadFromURLOptions) (*UploadFileResponse, error) {
+	maxSize := opts.MaxSize
+	if maxSize <= 0 || maxSize > maxUploadSize {
+		maxSize = maxUploadSize
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", sourceURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create source request: %w", err)
+	}
+
+	// Both transfers run as long as the stream lasts, so they are bounded
+	// by ctx rather than the client timeout.
+	httpClient := c.streamingClient()
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch source: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("fetch source: unexpected status %d", resp.StatusCode)
+	}
+	if resp.ContentLength > maxSize {
+		return nil, fmt.Errorf("file size exceeds the limit of %d bytes", maxSize)
+	}
+
+	filename := opts.Filename
+	if filename == "" {
+		filename = sourceFilename(resp)
+	}
+
+	body := &maxSizeReader{r: resp.Body, limit: maxSize, remaining: maxSize}
+	respBody, err := c.doMultipartRequest(ctx, httpClient, "/file/upload", "file", filename, resp.Header.Get("Content-Type"), body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response UploadFileResponse
+	if err := unmarshalResponse(respBody, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// sourceFilename derives a filename from a response's Content-Disposition
+// header, falling back to the last segment of the request URL path.
+func sourceFilename(resp *http.Response) string {
+	if cd := resp.Header.Get("Content-Disposition"); cd != "" {
+		if _, params, err := mime.ParseMediaType(cd); err == nil {
+			if name := cleanFilename(params["filename"]); name != "" {
+				return name
+			}
+		}
+	}
+
+	if resp.Request != nil && resp.Request.URL != nil {
+		if name, err := url.PathUnescape(path.Base(resp.Request.URL.EscapedPath())); err == nil {
+			if name := cleanFilename(name); name != "" {
+				return name
+			}
+		}
+	}
+
+	return "download"
+}
+
+// cleanFilename strips any directory components from name.
+func cleanFilename(name string) string {
+	name = name[strings.LastIndexAny(name, `/\`)+1:]
+	if name == "." || name == ".." {
+		return ""
+	}
+	return name
+}
+
+// maxSizeReader fails once more than remaining bytes have been read.
+type maxSizeReader struct {
+	r         io.Reader
+	limit     int64
+	remaining int64
+}
+
+func (m *maxSizeReader) Read(p []byte) (int, error) {
+	if m.remaining < 0 {
+		return 0, fmt.Errorf("file size exceeds the limit of %d bytes", m.limit)
+	}
+	if int64(len(p)) > m.remaining+1 {
+		p = p[:m.remaining+1]
+	}
+	n, err := m.r.Read(p)
+	m.remaining -= int64(n)
+	if m.remaining < 0 {
+		return n, fmt.Errorf("file size exceeds the limit of %d bytes", m.limit)
+	}
+	return n, err
+}
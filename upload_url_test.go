@@ -0,0 +1,185 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: upload_url_test.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:52:12
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 04:52:12
+//
+
+package seesdk
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newUploadServer returns an API server that records the uploaded file part.
+func newUploadServer(t *testing.T, filename, contentType, content *string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/file/upload" {
+			t.Errorf("Expected path /file/upload, got %s", r.URL.Path)
+		}
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Error("Expected file part, got:", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		data, _ := io.ReadAll(file)
+		*filename, *contentType, *content = header.Filename, header.Header.Get("Content-Type"), string(data)
+		w.Write([]byte(`{"code":200,"data":{"url":"https://s.ee/f/abc"}}`))
+	}))
+}
+
+func TestUploadFromURL(t *testing.T) {
+	source := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/assets/logo.svg":
+			w.Header().Set("Content-Type", "image/svg+xml")
+			w.Write([]byte("<svg/>"))
+		case "/export":
+			w.Header().Set("Content-Type", "text/csv")
+			w.Header().Set("Content-Disposition", `attachment; filename="../../etc/report.csv"`)
+			w.Write([]byte("a,b\n"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer source.Close()
+
+	var filename, contentType, content string
+	api := newUploadServer(t, &filename, &contentType, &content)
+	defer api.Close()
+
+	client := NewClient(Config{BaseURL: api.URL})
+	ctx := context.Background()
+
+	resp, err := client.UploadFromURL(ctx, source.URL+"/assets/logo.svg", UploadFromURLOptions{})
+	if err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	if resp.Data.URL != "https://s.ee/f/abc" {
+		t.Errorf("Unexpected response %+v", resp.Data)
+	}
+	if filename != "logo.svg" || contentType != "image/svg+xml" || content != "<svg/>" {
+		t.Errorf("Unexpected upload %q %q %q", filename, contentType, content)
+	}
+
+	if _, err := client.UploadFromURL(ctx, source.URL+"/export", UploadFromURLOptions{}); err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	if filename != "report.csv" || contentType != "text/csv" {
+		t.Errorf("Expected report.csv as text/csv, got %q %q", filename, contentType)
+	}
+
+	if _, err := client.UploadFromURL(ctx, source.URL+"/export", UploadFromURLOptions{Filename: "custom.csv"}); err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	if filename != "custom.csv" {
+		t.Errorf("Expected custom.csv, got %q", filename)
+	}
+
+	if _, err := client.UploadFromURL(ctx, source.URL+"/missing", UploadFromURLOptions{}); err == nil {
+		t.Error("Expected error for missing source")
+	}
+}
+
+func TestUploadFromURLMaxSize(t *testing.T) {
+	source := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Chunked, so the size is only known while streaming.
+		w.(http.Flusher).Flush()
+		w.Write([]byte(strings.Repeat("x", 100)))
+	}))
+	defer source.Close()
+
+	uploads := 0
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		uploads++
+		io.Copy(io.Discard, r.Body)
+		w.Write([]byte(`{"code":200,"data":{}}`))
+	}))
+	defer api.Close()
+
+	client := NewClient(Config{BaseURL: api.URL})
+	_, err := client.UploadFromURL(context.Background(), source.URL+"/big.bin", UploadFromURLOptions{MaxSize: 10})
+	if err == nil || !strings.Contains(err.Error(), "exceeds the limit") {
+		t.Errorf("Expected size limit error, got: %v", err)
+	}
+}
+
+func TestUploadFromURLIgnoresClientTimeout(t *testing.T) {
+	source := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for i := 0; i < 4; i++ {
+			w.Write([]byte("chunk"))
+			w.(http.Flusher).Flush()
+			time.Sleep(50 * time.Millisecond)
+		}
+	}))
+	defer source.Close()
+
+	var filename, contentType, content string
+	api := newUploadServer(t, &filename, &contentType, &content)
+	defer api.Close()
+
+	client := NewClient(Config{BaseURL: api.URL, Timeout: 100 * time.Millisecond})
+	if _, err := client.UploadFromURL(context.Background(), source.URL+"/slow.txt", UploadFromURLOptions{}); err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	if content != strings.Repeat("chunk", 4) {
+		t.Errorf("Expected full content, got %q", content)
+	}
+	if client.HTTPClient.Timeout != 100*time.Millisecond {
+		t.Error("Expected client timeout to be left unchanged")
+	}
+}
+
+func TestSourceFilename(t *testing.T) {
+	tests := []struct {
+		url         string
+		disposition string
+		want        string
+	}{
+		{"https://example.com/a/b/photo.jpg", "", "photo.jpg"},
+		{"https://example.com/files/my%20doc.pdf?x=1", "", "my doc.pdf"},
+		{"https://example.com/dl", `attachment; filename="report.csv"`, "report.csv"},
+		{"https://example.com/dl", `attachment; filename="..\\..\\win.ini"`, "win.ini"},
+		{"https://example.com/dl/x.txt", `attachment; filename=".."`, "x.txt"},
+		{"https://example.com/", "", "download"},
+		{"https://example.com/a/%2E%2E", "", "download"},
+	}
+
+	for _, tt := range tests {
+		u, _ := url.Parse(tt.url)
+		resp := &http.Response{Header: http.Header{}, Request: &http.Request{URL: u}}
+		if tt.disposition != "" {
+			resp.Header.Set("Content-Disposition", tt.disposition)
+		}
+		if got := sourceFilename(resp); got != tt.want {
+			t.Errorf("%s %s: expected %q, got %q", tt.url, tt.disposition, tt.want, got)
+		}
+	}
+}
+
+func TestMaxSizeReader(t *testing.T) {
+	r := &maxSizeReader{r: strings.NewReader("0123456789"), limit: 10, remaining: 10}
+	if data, err := io.ReadAll(r); err != nil || len(data) != 10 {
+		t.Errorf("Expected 10 bytes at the limit, got %d, %v", len(data), err)
+	}
+
+	r = &maxSizeReader{r: strings.NewReader("0123456789x"), limit: 10, remaining: 10}
+	if _, err := io.ReadAll(r); err == nil {
+		t.Error("Expected error past the limit")
+	}
+}
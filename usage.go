@@ -0,0 +1,216 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: usage.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:37:14
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 05:17:26
+//
+
+package seesdk
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// UsageCounter identifies one usage counter of an account.
+type UsageCounter string
+
+const (
+	UsageAPIDay      UsageCounter = "api_day"
+	UsageAPIMonth    UsageCounter = "api_month"
+	UsageLinkDay     UsageCounter = "link_day"
+	UsageLinkMonth   UsageCounter = "link_month"
+	UsageQRCodeDay   UsageCounter = "qrcode_day"
+	UsageQRCodeMonth UsageCounter = "qrcode_month"
+)
+
+// CounterUsage is the current value and limit of one usage counter.
+type CounterUsage struct {
+	Counter UsageCounter
+	Used    int
+	Limit   int // UsageNoLimit if unlimited
+}
+
+// Unlimited reports whether the counter has no limit.
+func (u CounterUsage) Unlimited() bool {
+	return u.Limit == UsageNoLimit
+}
+
+// Utilization returns Used/Limit, where 1 means the limit is reached.
+// Unlimited counters and counters without a reported limit return 0.
+func (u CounterUsage) Utilization() float64 {
+	if u.Limit <= 0 {
+		return 0
+	}
+	return float64(u.Used) / float64(u.Limit)
+}
+
+// Counters returns all usage counters with their limits.
+func (u Usage) Counters() []CounterUsage {
+	return []CounterUsage{
+		{UsageAPIDay, u.APICountDay, u.APICountDayLimit},
+		{UsageAPIMonth, u.APICountMonth, u.APICountMonthLimit},
+		{UsageLinkDay, u.LinkCountDay, u.LinkCountDayLimit},
+		{UsageLinkMonth, u.LinkCountMonth, u.LinkCountMonthLimit},
+		{UsageQRCodeDay, u.QRCodeCountDay, u.QRCodeCountDayLimit},
+		{UsageQRCodeMonth, u.QRCodeCountMonth, u.QRCodeCountMonthLimit},
+	}
+}
+
+const (
+	// DefaultUsagePollInterval is the default interval between GetUsage calls.
+	DefaultUsagePollInterval = 5 * time.Minute
+	// DefaultUsageHysteresis is the default margin below a threshold at
+	// which an alert is cleared.
+	DefaultUsageHysteresis = 0.05
+)
+
+// DefaultUsageThresholds are the utilization levels alerted on by default.
+var DefaultUsageThresholds = []float64{0.8, 0.95}
+
+// UsageAlert reports that a counter crossed a threshold.
+type UsageAlert struct {
+	CounterUsage
+	Threshold   float64
+	Utilization float64
+	// Cleared is true when utilization fell back below the threshold minus
+	// the hysteresis, for example after a daily reset.
+	Cleared bool
+	Time    time.Time
+}
+
+// UsageMonitorOptions contains options for a UsageMonitor.
+type UsageMonitorOptions struct {
+	// Interval between polls (default: DefaultUsagePollInterval).
+	Interval time.Duration
+	// Thresholds are utilization levels between 0 and 1
+	// (default: DefaultUsageThresholds).
+	Thresholds []float64
+	// Hysteresis is how far utilization must drop below a threshold before
+	// the alert is cleared and can fire again (default: DefaultUsageHysteresis).
+	// Use a negative value for none.
+	Hysteresis float64
+	// OnAlert is called for every alert. It runs on the polling goroutine.
+	OnAlert func(UsageAlert)
+	// OnError is called when polling fails.
+	OnError func(error)
+}
+
+// UsageMonitor polls GetUsage and raises alerts when counters cross the
+// configured thresholds.
+type UsageMonitor struct {
+	client *Client
+	opts   UsageMonitorOptions
+	alerts chan UsageAlert
+
+	mu     sync.Mutex
+	active map[UsageCounter]map[float64]bool
+}
+
+// NewUsageMonitor creates a UsageMonitor. Call Run to start polling.
+func NewUsageMonitor(client *Client, opts UsageMonitorOptions) *UsageMonitor {
+	if opts.Interval <= 0 {
+		opts.Interval = DefaultUsagePollInterval
+	}
+	if len(opts.Thresholds) == 0 {
+		opts.Thresholds = DefaultUsageThresholds
+	}
+	opts.Thresholds = append([]float64(nil), opts.Thresholds...)
+	sort.Float64s(opts.Thresholds)
+	if opts.Hysteresis == 0 {
+		opts.Hysteresis = DefaultUsageHysteresis
+	} else if opts.Hysteresis < 0 {
+		opts.Hysteresis = 0
+	}
+	return &UsageMonitor{
+		client: client,
+		opts:   opts,
+		alerts: make(chan UsageAlert, 16),
+		active: map[UsageCounter]map[float64]bool{},
+	}
+}
+
+// Alerts returns a channel that receives every alert. Alerts are dropped
+// when the channel buffer is full, so callers that cannot keep up should
+// use OnAlert instead.
+func (m *UsageMonitor) Alerts() <-chan UsageAlert {
+	return m.alerts
+}
+
+// Run polls usage immediately and then every interval until ctx is done.
+// It returns ctx.Err().
+func (m *UsageMonitor) Run(ctx context.Context) error {
+	ticker := time.NewTicker(m.opts.Interval)
+	defer ticker.Stop()
+	for {
+		if _, err := m.Check(); err != nil && m.opts.OnError != nil {
+			m.opts.OnError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check polls usage once and delivers the resulting alerts.
+func (m *UsageMonitor) Check() ([]UsageAlert, error) {
+	resp, err := m.client.GetUsage()
+	if err != nil {
+		return nil, err
+	}
+
+	alerts := m.Evaluate(resp.Data)
+	for _, alert := range alerts {
+		if m.opts.OnAlert != nil {
+			m.opts.OnAlert(alert)
+		}
+		select {
+		case m.alerts <- alert:
+		default:
+		}
+	}
+	return alerts, nil
+}
+
+// Evaluate updates the alert state from usage and returns the alerts raised
+// or cleared. It does not deliver them.
+func (m *UsageMonitor) Evaluate(usage Usage) []UsageAlert {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	var alerts []UsageAlert
+	for _, counter := range usage.Counters() {
+		utilization := counter.Utilization()
+		active := m.active[counter.Counter]
+		if active == nil {
+			active = map[float64]bool{}
+			m.active[counter.Counter] = active
+		}
+
+		for _, threshold := range m.opts.Thresholds {
+			alert := UsageAlert{CounterUsage: counter, Threshold: threshold, Utilization: utilization, Time: now}
+			switch {
+			case !active[threshold] && counter.Limit > 0 && utilization >= threshold:
+				active[threshold] = true
+				alerts = append(alerts, alert)
+			case active[threshold] && (counter.Limit <= 0 || utilization < threshold-m.opts.Hysteresis):
+				delete(active, threshold)
+				alert.Cleared = true
+				alerts = append(alerts, alert)
+			}
+		}
+	}
+	return alerts
+}
@@ -0,0 +1,270 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: usage_forecast.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:38:07
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 05:01:49
+//
+
+package seesdk
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// UsageSnapshot is the usage of an account at a point in time.
+type UsageSnapshot struct {
+	Time  time.Time `json:"time"`
+	Usage Usage     `json:"usage"`
+}
+
+// UsageProjection is the projected exhaustion of one usage counter.
+// Daily counters are assumed to reset at midnight UTC and monthly counters
+// on the first day of the month. A counter that decreases between snapshots
+// is treated as reset as well.
+type UsageProjection struct {
+	CounterUsage
+	// Rate is the growth of the counter per hour in the current period.
+	Rate float64
+	// ResetAt is the start of the next period.
+	ResetAt time.Time
+	// ExhaustsAt is when the limit is reached at the current rate. It is
+	// zero for unlimited counters and counters that are not growing.
+	ExhaustsAt time.Time
+	// BeforeReset reports whether ExhaustsAt falls before ResetAt.
+	BeforeReset bool
+}
+
+// Exhausted reports whether the limit has been reached.
+func (p UsageProjection) Exhausted() bool {
+	return p.Limit > 0 && p.Used >= p.Limit
+}
+
+// ForecastUsage projects the exhaustion time of every counter from
+// snapshots, which must be in chronological order. Counters whose period
+// has ended by now are reported as reset.
+func ForecastUsage(snapshots []UsageSnapshot, now time.Time) []UsageProjection {
+	if len(snapshots) == 0 {
+		return nil
+	}
+
+	latest := snapshots[len(snapshots)-1]
+	var projections []UsageProjection
+	for i, counter := range latest.Usage.Counters() {
+		start, reset := usagePeriod(counter.Counter, latest.Time)
+		if !now.Before(reset) {
+			// The counter has reset since the latest snapshot.
+			counter.Used = 0
+			_, reset = usagePeriod(counter.Counter, now)
+			projections = append(projections, UsageProjection{CounterUsage: counter, ResetAt: reset})
+			continue
+		}
+		p := UsageProjection{CounterUsage: counter, ResetAt: reset}
+
+		// Find the first snapshot of the current period.
+		first := len(snapshots) - 1
+		for first > 0 {
+			prev := snapshots[first-1]
+			if prev.Time.Before(start) || prev.Usage.Counters()[i].Used > snapshots[first].Usage.Counters()[i].Used {
+				break
+			}
+			first--
+		}
+
+		// Use the observed growth, or the average since the period started
+		// when there is only one snapshot in the period.
+		from, used := start, 0
+		if first < len(snapshots)-1 {
+			from, used = snapshots[first].Time, snapshots[first].Usage.Counters()[i].Used
+		}
+		if hours := latest.Time.Sub(from).Hours(); hours > 0 {
+			p.Rate = float64(counter.Used-used) / hours
+		}
+
+		if counter.Limit > 0 {
+			switch {
+			case p.Exhausted():
+				p.ExhaustsAt = latest.Time
+			case p.Rate > 0:
+				remaining := float64(counter.Limit-counter.Used) / p.Rate
+				p.ExhaustsAt = latest.Time.Add(time.Duration(remaining * float64(time.Hour)))
+			}
+			p.BeforeReset = !p.ExhaustsAt.IsZero() && p.ExhaustsAt.Before(reset)
+		}
+		projections = append(projections, p)
+	}
+	return projections
+}
+
+// usagePeriod returns the start of the period containing t and the start of
+// the next one.
+func usagePeriod(counter UsageCounter, t time.Time) (start, next time.Time) {
+	t = t.UTC()
+	switch counter {
+	case UsageAPIMonth, UsageLinkMonth, UsageQRCodeMonth:
+		start = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(0, 1, 0)
+	default:
+		start = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(0, 0, 1)
+	}
+}
+
+// UsageRecorder records usage snapshots to a local file with one JSON
+// object per line, so that forecasts survive restarts.
+type UsageRecorder struct {
+	path string
+
+	mu        sync.Mutex
+	snapshots []UsageSnapshot
+}
+
+// OpenUsageRecorder opens or creates the snapshot file at path and loads
+// the snapshots it contains. A partially written last line is dropped.
+func OpenUsageRecorder(path string) (*UsageRecorder, error) {
+	r := &UsageRecorder{path: path}
+
+	data, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("read usage snapshots: %w", err)
+	}
+
+	// good is the length of the file up to the last complete snapshot.
+	good := 0
+	for line := 1; good < len(data); line++ {
+		end := bytes.IndexByte(data[good:], '\n')
+		if end < 0 {
+			break // torn write
+		}
+		entry := bytes.TrimSpace(data[good : good+end])
+		if len(entry) > 0 {
+			var snapshot UsageSnapshot
+			if err := json.Unmarshal(entry, &snapshot); err != nil {
+				if good+end+1 == len(data) {
+					break
+				}
+				return nil, fmt.Errorf("parse usage snapshot on line %d: %w", line, err)
+			}
+			r.snapshots = append(r.snapshots, snapshot)
+		}
+		good += end + 1
+	}
+
+	// Drop a torn last line so that the next Record starts on a new line.
+	if good < len(data) {
+		if err := os.Truncate(path, int64(good)); err != nil {
+			return nil, fmt.Errorf("truncate usage snapshots: %w", err)
+		}
+	}
+	return r, nil
+}
+
+// Poll fetches the current usage and records it.
+func (r *UsageRecorder) Poll(client *Client) (*UsageSnapshot, error) {
+	resp, err := client.GetUsage()
+	if err != nil {
+		return nil, err
+	}
+	snapshot := UsageSnapshot{Time: time.Now(), Usage: resp.Data}
+	if err := r.Record(snapshot); err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
+
+// Record appends a snapshot to the file.
+func (r *UsageRecorder) Record(snapshot UsageSnapshot) error {
+	line, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("marshal usage snapshot: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	f, err := os.OpenFile(r.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("open usage snapshots: %w", err)
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		f.Close()
+		return fmt.Errorf("write usage snapshot: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("close usage snapshots: %w", err)
+	}
+
+	r.snapshots = append(r.snapshots, snapshot)
+	return nil
+}
+
+// Snapshots returns the recorded snapshots in chronological order.
+func (r *UsageRecorder) Snapshots() []UsageSnapshot {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]UsageSnapshot(nil), r.snapshots...)
+}
+
+// Forecast projects the exhaustion time of every counter from the recorded
+// snapshots.
+func (r *UsageRecorder) Forecast(now time.Time) []UsageProjection {
+	return ForecastUsage(r.Snapshots(), now)
+}
+
+// Prune removes snapshots taken before t and rewrites the file.
+func (r *UsageRecorder) Prune(before time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var kept []UsageSnapshot
+	for _, snapshot := range r.snapshots {
+		if !snapshot.Time.Before(before) {
+			kept = append(kept, snapshot)
+		}
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(r.path), filepath.Base(r.path)+".*")
+	if err != nil {
+		return fmt.Errorf("create usage snapshots: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if err := tmp.Chmod(0o644); err != nil {
+		tmp.Close()
+		return fmt.Errorf("create usage snapshots: %w", err)
+	}
+
+	w := bufio.NewWriter(tmp)
+	enc := json.NewEncoder(w)
+	for _, snapshot := range kept {
+		if err := enc.Encode(snapshot); err != nil {
+			tmp.Close()
+			return fmt.Errorf("write usage snapshot: %w", err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("write usage snapshots: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("close usage snapshots: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), r.path); err != nil {
+		return fmt.Errorf("replace usage snapshots: %w", err)
+	}
+
+	r.snapshots = kept
+	return nil
+}
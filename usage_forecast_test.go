@@ -0,0 +1,126 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: usage_forecast_test.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:38:23
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 05:01:49
+//
+
+package seesdk
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestForecastUsage(t *testing.T) {
+	day := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	snapshot := func(hour, links int) UsageSnapshot {
+		return UsageSnapshot{
+			Time:  day.Add(time.Duration(hour) * time.Hour),
+			Usage: Usage{LinkCountDay: links, LinkCountDayLimit: 100, APICountDayLimit: UsageNoLimit},
+		}
+	}
+
+	// Yesterday's snapshot and a reset are ignored; 10 links per hour from 02:00.
+	snapshots := []UsageSnapshot{
+		{Time: day.Add(-time.Hour), Usage: Usage{LinkCountDay: 90, LinkCountDayLimit: 100}},
+		snapshot(2, 20),
+		snapshot(4, 40),
+	}
+	projections := ForecastUsage(snapshots, day.Add(4*time.Hour))
+
+	var links UsageProjection
+	for _, p := range projections {
+		if p.Counter == UsageLinkDay {
+			links = p
+		}
+	}
+	if links.Rate != 10 {
+		t.Errorf("Expected rate 10/h, got %v", links.Rate)
+	}
+	if want := day.Add(10 * time.Hour); !links.ExhaustsAt.Equal(want) {
+		t.Errorf("Expected exhaustion at %v, got %v", want, links.ExhaustsAt)
+	}
+	if !links.BeforeReset || !links.ResetAt.Equal(day.AddDate(0, 0, 1)) {
+		t.Errorf("Expected exhaustion before reset at midnight, got %+v", links)
+	}
+
+	// A single snapshot uses the average since midnight.
+	projections = ForecastUsage([]UsageSnapshot{snapshot(5, 10)}, day.Add(5*time.Hour))
+	if projections[2].Rate != 2 || projections[2].BeforeReset {
+		t.Errorf("Expected rate 2/h without exhaustion before reset, got %+v", projections[2])
+	}
+
+	// Unlimited counters never exhaust.
+	if !projections[0].ExhaustsAt.IsZero() {
+		t.Errorf("Expected no exhaustion for unlimited counter, got %v", projections[0].ExhaustsAt)
+	}
+
+	// After midnight the daily counter is reported as reset.
+	projections = ForecastUsage(snapshots, day.AddDate(0, 0, 1).Add(time.Hour))
+	if projections[2].Used != 0 || !projections[2].ExhaustsAt.IsZero() {
+		t.Errorf("Expected reset counter, got %+v", projections[2])
+	}
+}
+
+func TestUsageRecorder(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "usage.jsonl")
+	base := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	r, err := OpenUsageRecorder(path)
+	if err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	for i := 0; i < 3; i++ {
+		snapshot := UsageSnapshot{Time: base.Add(time.Duration(i) * time.Hour), Usage: Usage{LinkCountDay: i}}
+		if err := r.Record(snapshot); err != nil {
+			t.Fatal("Expected no error, got:", err)
+		}
+	}
+
+	// Simulate a crash in the middle of a write.
+	f, _ := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+	f.WriteString(`{"time":"2026-03`)
+	f.Close()
+
+	r, err = OpenUsageRecorder(path)
+	if err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	if got := len(r.Snapshots()); got != 3 {
+		t.Fatalf("Expected 3 snapshots, got %d", got)
+	}
+
+	// Records after the crash must not be glued to the torn line.
+	for i := 3; i < 5; i++ {
+		snapshot := UsageSnapshot{Time: base.Add(time.Duration(i) * time.Hour), Usage: Usage{LinkCountDay: i}}
+		if err := r.Record(snapshot); err != nil {
+			t.Fatal("Expected no error, got:", err)
+		}
+	}
+	r, err = OpenUsageRecorder(path)
+	if err != nil {
+		t.Fatal("Expected no error after reopening, got:", err)
+	}
+	if got := len(r.Snapshots()); got != 5 {
+		t.Fatalf("Expected 5 snapshots, got %d", got)
+	}
+
+	if err := r.Prune(base.Add(time.Hour)); err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+	r, _ = OpenUsageRecorder(path)
+	snapshots := r.Snapshots()
+	if len(snapshots) != 4 || snapshots[0].Usage.LinkCountDay != 1 {
+		t.Errorf("Expected 4 snapshots after pruning, got %+v", snapshots)
+	}
+}
@@ -0,0 +1,115 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: usage_test.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:37:14
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 05:17:26
+//
+
+package seesdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCounterUsageUtilization(t *testing.T) {
+	tests := []struct {
+		usage CounterUsage
+		want  float64
+	}{
+		{CounterUsage{Used: 80, Limit: 100}, 0.8},
+		{CounterUsage{Used: 5, Limit: UsageNoLimit}, 0},
+		{CounterUsage{Used: 3, Limit: 0}, 0},
+	}
+	for _, tt := range tests {
+		if got := tt.usage.Utilization(); got != tt.want {
+			t.Errorf("Expected utilization %v for %+v, got %v", tt.want, tt.usage, got)
+		}
+	}
+}
+
+func TestUsageMonitorEvaluate(t *testing.T) {
+	m := NewUsageMonitor(nil, UsageMonitorOptions{Thresholds: []float64{0.8}, Hysteresis: 0.1})
+	usage := func(links int) Usage {
+		return Usage{LinkCountMonth: links, LinkCountMonthLimit: 100, APICountDayLimit: UsageNoLimit}
+	}
+
+	steps := []struct {
+		links   int
+		alerts  int
+		cleared bool
+	}{
+		{50, 0, false},
+		{80, 1, false},
+		{85, 0, false}, // already active
+		{75, 0, false}, // within hysteresis
+		{81, 0, false}, // still active, no flapping
+		{69, 1, true},
+		{90, 1, false},
+	}
+
+	for i, step := range steps {
+		alerts := m.Evaluate(usage(step.links))
+		if len(alerts) != step.alerts {
+			t.Fatalf("Step %d: expected %d alerts, got %v", i, step.alerts, alerts)
+		}
+		if len(alerts) == 1 {
+			if alerts[0].Counter != UsageLinkMonth || alerts[0].Cleared != step.cleared {
+				t.Errorf("Step %d: unexpected alert %+v", i, alerts[0])
+			}
+		}
+	}
+}
+
+func TestUsageMonitorNoHysteresis(t *testing.T) {
+	m := NewUsageMonitor(nil, UsageMonitorOptions{Thresholds: []float64{0.8}, Hysteresis: -1})
+	usage := func(links int) Usage {
+		return Usage{LinkCountMonth: links, LinkCountMonthLimit: 100, APICountDayLimit: UsageNoLimit}
+	}
+
+	m.Evaluate(usage(80))
+	alerts := m.Evaluate(usage(79))
+	if len(alerts) != 1 || !alerts[0].Cleared {
+		t.Errorf("Expected alert to clear right below the threshold, got %v", alerts)
+	}
+}
+
+func TestUsageMonitorRun(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"code":200,"data":{"link_count_day":9,"link_count_day_limit":10}}`))
+	}))
+	defer server.Close()
+
+	var callbacks int
+	m := NewUsageMonitor(NewClient(Config{BaseURL: server.URL}), UsageMonitorOptions{
+		Interval: time.Millisecond,
+		OnAlert:  func(UsageAlert) { callbacks++ },
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	m.Run(ctx)
+
+	// link_day is at 90%, which crosses 0.8 but not 0.95.
+	select {
+	case alert := <-m.Alerts():
+		if alert.Counter != UsageLinkDay || alert.Threshold != 0.8 {
+			t.Errorf("Unexpected alert %+v", alert)
+		}
+	default:
+		t.Fatal("Expected an alert on the channel")
+	}
+	if callbacks != 1 {
+		t.Errorf("Expected 1 callback, got %d", callbacks)
+	}
+}
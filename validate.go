@@ -0,0 +1,293 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: validate.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:31:58
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 04:35:45
+//
+
+package seesdk
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// MaxTextContentSize is the maximum size of text content in bytes.
+const MaxTextContentSize = 1024 * 1024
+
+// maxSlugLength is the maximum length of a custom slug.
+const maxSlugLength = 64
+
+// FieldError describes one invalid field of a request.
+type FieldError struct {
+	Field   string // JSON name of the field
+	Message string
+}
+
+func (e FieldError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+// ValidationError is returned when a request fails validation before it is
+// sent. It lists every invalid field.
+type ValidationError struct {
+	Errors []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, fe := range e.Errors {
+		msgs[i] = fe.Error()
+	}
+	return "invalid request: " + strings.Join(msgs, "; ")
+}
+
+// validator collects field errors.
+type validator struct {
+	errs []FieldError
+}
+
+func (v *validator) add(field, format string, args ...any) {
+	v.errs = append(v.errs, FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return &ValidationError{Errors: v.errs}
+}
+
+func (v *validator) required(field, value string) {
+	if strings.TrimSpace(value) == "" {
+		v.add(field, "is required")
+	}
+}
+
+// httpURL checks that value is an absolute http or https URL.
+func (v *validator) httpURL(field, value string) {
+	u, err := url.Parse(value)
+	if err != nil {
+		v.add(field, "is not a valid URL")
+		return
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		v.add(field, "must use the http or https scheme")
+		return
+	}
+	if u.Host == "" {
+		v.add(field, "must include a host")
+	}
+}
+
+// hostname checks that value looks like a fully qualified domain name.
+func (v *validator) hostname(field, value string) {
+	if value == "" {
+		v.add(field, "is required")
+		return
+	}
+	labels := strings.Split(value, ".")
+	if len(value) > 253 || len(labels) < 2 {
+		v.add(field, "is not a valid domain name")
+		return
+	}
+	for _, label := range labels {
+		if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			v.add(field, "is not a valid domain name")
+			return
+		}
+		for _, r := range label {
+			if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-') {
+				v.add(field, "is not a valid domain name")
+				return
+			}
+		}
+	}
+}
+
+func (v *validator) slug(field, value string) {
+	if value == "" {
+		return
+	}
+	if len(value) > maxSlugLength {
+		v.add(field, "must be at most %d characters", maxSlugLength)
+	}
+	for _, r := range value {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_') {
+			v.add(field, "may only contain letters, digits, '-' and '_'")
+			return
+		}
+	}
+}
+
+func (v *validator) expiry(field string, e Expiry) {
+	if err := e.Validate(); err != nil {
+		v.add(field, "%s", err.Error())
+	}
+}
+
+func (v *validator) tagIDs(field string, ids []int64) {
+	for _, id := range ids {
+		if id <= 0 {
+			v.add(field, "contains invalid tag ID %d", id)
+			return
+		}
+	}
+}
+
+func (v *validator) content(field, value string) {
+	if len(value) > MaxTextContentSize {
+		v.add(field, "exceeds the limit of %d bytes", MaxTextContentSize)
+	}
+}
+
+// Validate checks the request for errors that the API would reject.
+func (r CreateShortURLRequest) Validate() error {
+	var v validator
+	v.required("target_url", r.TargetURL)
+	if r.TargetURL != "" {
+		v.httpURL("target_url", r.TargetURL)
+	}
+	v.slug("custom_slug", r.CustomSlug)
+	if r.ExpirationRedirectURL != "" {
+		v.httpURL("expiration_redirect_url", r.ExpirationRedirectURL)
+	}
+	v.expiry("expire_at", r.ExpireAt)
+	v.tagIDs("tag_ids", r.TagIDs)
+	return v.err()
+}
+
+// Validate checks the request for errors that the API would reject.
+func (r UpdateShortURLRequest) Validate() error {
+	var v validator
+	v.required("domain", r.Domain)
+	v.required("slug", r.Slug)
+	if r.TargetURL != "" {
+		v.httpURL("target_url", r.TargetURL)
+	}
+	if redirect, ok := r.ExpirationRedirectURL.Get(); ok && redirect != "" {
+		v.httpURL("expiration_redirect_url", redirect)
+	}
+	if expireAt, ok := r.ExpireAt.Get(); ok {
+		v.expiry("expire_at", expireAt)
+	}
+	if tagIDs, ok := r.TagIDs.Get(); ok {
+		v.tagIDs("tag_ids", tagIDs)
+	}
+	return v.err()
+}
+
+// Validate checks the request for errors that the API would reject.
+func (r DeleteURLRequest) Validate() error {
+	var v validator
+	v.required("domain", r.Domain)
+	v.required("slug", r.Slug)
+	return v.err()
+}
+
+// Validate checks the request for errors that the API would reject.
+func (r CreateTextRequest) Validate() error {
+	var v validator
+	v.required("content", r.Content)
+	v.content("content", r.Content)
+	v.slug("custom_slug", r.CustomSlug)
+	v.expiry("expire_at", r.ExpireAt)
+	v.tagIDs("tag_ids", r.TagIDs)
+	return v.err()
+}
+
+// Validate checks the request for errors that the API would reject.
+func (r UpdateTextRequest) Validate() error {
+	var v validator
+	v.required("domain", r.Domain)
+	v.required("slug", r.Slug)
+	v.content("content", r.Content)
+	if expireAt, ok := r.ExpireAt.Get(); ok {
+		v.expiry("expire_at", expireAt)
+	}
+	if tagIDs, ok := r.TagIDs.Get(); ok {
+		v.tagIDs("tag_ids", tagIDs)
+	}
+	return v.err()
+}
+
+// Validate checks the request for errors that the API would reject.
+func (r DeleteTextRequest) Validate() error {
+	var v validator
+	v.required("domain", r.Domain)
+	v.required("slug", r.Slug)
+	return v.err()
+}
+
+// Validate checks the request for errors that the API would reject.
+func (r CreateTagRequest) Validate() error {
+	var v validator
+	v.required("name", r.Name)
+	return v.err()
+}
+
+// Validate checks the request for errors that the API would reject.
+func (r RenameTagRequest) Validate() error {
+	var v validator
+	if r.ID <= 0 {
+		v.add("id", "is required")
+	}
+	v.required("name", r.Name)
+	return v.err()
+}
+
+// Validate checks the request for errors that the API would reject.
+func (r DeleteTagRequest) Validate() error {
+	var v validator
+	if r.ID <= 0 {
+		v.add("id", "is required")
+	}
+	return v.err()
+}
+
+// Validate checks the request for errors that the API would reject.
+func (r MergeTagsRequest) Validate() error {
+	var v validator
+	if r.SourceID <= 0 {
+		v.add("source_id", "is required")
+	}
+	if r.TargetID <= 0 {
+		v.add("target_id", "is required")
+	}
+	if r.SourceID > 0 && r.SourceID == r.TargetID {
+		v.add("target_id", "must differ from source_id")
+	}
+	return v.err()
+}
+
+// Validate checks the request for errors that the API would reject.
+func (r AddCustomDomainRequest) Validate() error {
+	var v validator
+	v.hostname("domain", r.Domain)
+	return v.err()
+}
+
+// Validate checks the request for errors that the API would reject.
+func (r SetDomainFallbackRequest) Validate() error {
+	var v validator
+	v.required("domain", r.Domain)
+	if r.FallbackURL != "" {
+		v.httpURL("fallback_url", r.FallbackURL)
+	}
+	return v.err()
+}
+
+// Validate checks the request for errors that the API would reject.
+func (r RemoveCustomDomainRequest) Validate() error {
+	var v validator
+	v.required("domain", r.Domain)
+	return v.err()
+}
@@ -0,0 +1,120 @@
+//
+// Copyright (c) 2025-2026 S.EE Development Team
+//
+// This source code is licensed under the MIT License,
+// which is located in the LICENSE file in the source tree's root directory.
+//
+// File: validate_test.go
+// Author: S.EE Development Team <dev@s.ee>
+// File Created: 2026-10-19 04:33:30
+//
+// Modified By: S.EE Development Team <dev@s.ee>
+// Last Modified: 2026-10-19 04:34:36
+//
+
+package seesdk
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCreateShortURLRequestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		req    CreateShortURLRequest
+		fields []string
+	}{
+		{"valid", CreateShortURLRequest{Domain: "s.ee", TargetURL: "https://example.com"}, nil},
+		{"empty target", CreateShortURLRequest{Domain: "s.ee"}, []string{"target_url"}},
+		{"ftp target", CreateShortURLRequest{Domain: "s.ee", TargetURL: "ftp://example.com"}, []string{"target_url"}},
+		{"no host", CreateShortURLRequest{Domain: "s.ee", TargetURL: "https:///path"}, []string{"target_url"}},
+		{"slug with space", CreateShortURLRequest{Domain: "s.ee", TargetURL: "https://example.com", CustomSlug: "my link"}, []string{"custom_slug"}},
+		{"several", CreateShortURLRequest{CustomSlug: "a/b"}, []string{"target_url", "custom_slug"}},
+	}
+
+	for _, tt := range tests {
+		err := tt.req.Validate()
+		if tt.fields == nil {
+			if err != nil {
+				t.Errorf("%s: expected no error, got: %v", tt.name, err)
+			}
+			continue
+		}
+
+		var verr *ValidationError
+		if !errors.As(err, &verr) {
+			t.Errorf("%s: expected ValidationError, got: %v", tt.name, err)
+			continue
+		}
+		var fields []string
+		for _, fe := range verr.Errors {
+			fields = append(fields, fe.Field)
+		}
+		if strings.Join(fields, ",") != strings.Join(tt.fields, ",") {
+			t.Errorf("%s: expected fields %v, got %v", tt.name, tt.fields, fields)
+		}
+	}
+}
+
+func TestCreateTextRequestValidate(t *testing.T) {
+	if err := (CreateTextRequest{Content: "hello"}).Validate(); err != nil {
+		t.Error("Expected no error, got:", err)
+	}
+	if err := (CreateTextRequest{}).Validate(); err == nil {
+		t.Error("Expected error for empty content")
+	}
+	big := CreateTextRequest{Content: strings.Repeat("a", MaxTextContentSize+1)}
+	if err := big.Validate(); err == nil {
+		t.Error("Expected error for oversized content")
+	}
+}
+
+func TestValidationSkipsRequest(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{BaseURL: server.URL})
+	_, err := client.CreateShortURL(CreateShortURLRequest{Domain: "s.ee", TargetURL: "javascript:alert(1)"})
+	if err == nil {
+		t.Fatal("Expected validation error")
+	}
+	if requests != 0 {
+		t.Errorf("Expected no requests, got %d", requests)
+	}
+}
+
+func TestVerifyDomains(t *testing.T) {
+	domainRequests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/domains":
+			domainRequests++
+			w.Write([]byte(`{"code":200,"data":{"domains":["s.ee","example.link"]}}`))
+		case "/shorten":
+			w.Write([]byte(`{"code":200,"data":{"slug":"abc"}}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{BaseURL: server.URL, VerifyDomains: true})
+
+	if _, err := client.CreateShortURL(CreateShortURLRequest{Domain: "s.ee", TargetURL: "https://example.com"}); err != nil {
+		t.Fatal("Expected no error, got:", err)
+	}
+
+	_, err := client.CreateShortURL(CreateShortURLRequest{Domain: "unknown.test", TargetURL: "https://example.com"})
+	var verr *ValidationError
+	if !errors.As(err, &verr) || verr.Errors[0].Field != "domain" {
+		t.Fatalf("Expected domain validation error, got: %v", err)
+	}
+	if domainRequests != 1 {
+		t.Errorf("Expected domains to be fetched once, got %d", domainRequests)
+	}
+}